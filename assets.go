@@ -3,6 +3,13 @@ package echoswagger
 // CDN refer to https://cdnjs.com/libraries/swagger-ui
 const DefaultCDN = "https://cdnjs.cloudflare.com/ajax/libs/swagger-ui/4.11.1"
 
+// Scripts of other renderers, refer to https://www.jsdelivr.com
+const (
+	DefaultReDocCDN   = "https://cdn.jsdelivr.net/npm/redoc@2.1.3/bundles/redoc.standalone.js"
+	DefaultRapiDocCDN = "https://cdn.jsdelivr.net/npm/rapidoc@9.3.4/dist/rapidoc-min.js"
+	DefaultScalarCDN  = "https://cdn.jsdelivr.net/npm/@scalar/api-reference@1.24.0"
+)
+
 const SwaggerUIContent = `{{define "swagger"}}
 <!DOCTYPE html>
 <html lang="en">
@@ -47,16 +54,17 @@ const SwaggerUIContent = `{{define "swagger"}}
     <script src="{{.cdn}}/swagger-ui-standalone-preset.js" charset="UTF-8" crossorigin="anonymous"></script>
     <script>
     window.onload = function() {
-      var specPath = "{{.specName}}"
-      if (!window.location.pathname.endsWith("/")) {
-        specPath = "/" + specPath
+      {{template "specUrl" .}}
+      var urls = {{.urls}}
+      if (urls) {
+        urls = urls.map(function (u) { return {name: u.name, url: docUrl + "/" + u.path} })
       }
       var specStr = "{{.spec}}"
       var spec = specStr ? JSON.parse(specStr) : undefined
-      if (spec) {
+      if (spec && {{.rewriteHost}}) {
         spec.host = window.location.host
         var docPath = "{{.docPath}}"
-        var basePath = window.location.pathname
+        var basePath = pagePath
         if (!docPath.endsWith("/")) { docPath += "/" }
         if (!basePath.endsWith("/")) { basePath += "/" }
         if (basePath.endsWith(docPath)) {
@@ -65,8 +73,10 @@ const SwaggerUIContent = `{{define "swagger"}}
         spec.basePath = basePath
       }
       // Begin Swagger UI call region
-      const ui = SwaggerUIBundle({
-        url: window.location.origin+window.location.pathname+specPath,
+      const ui = SwaggerUIBundle(Object.assign({
+        url: specUrl,
+        urls: urls,
+        oauth2RedirectUrl: docUrl + "/{{.oauth2RedirectName}}",
         spec: spec,
         dom_id: '#swagger-ui',
         deepLinking: true,
@@ -78,8 +88,11 @@ const SwaggerUIContent = `{{define "swagger"}}
           SwaggerUIBundle.plugins.DownloadUrl
         ],
         layout: "StandaloneLayout"
-      });
+      }, {{.config}}));
       // End Swagger UI call region
+      {{if .initOAuth}}
+      ui.initOAuth({{.initOAuth}});
+      {{end}}
 
       window.ui = ui;
     };
@@ -87,3 +100,168 @@ const SwaggerUIContent = `{{define "swagger"}}
   </body>
 </html>
 {{end}}`
+
+// specURLScript sets variable specUrl to URL of the spec endpoint,
+// relative to the page which may be mounted under a sub-path of docPath.
+const specURLScript = `{{define "specUrl"}}
+      var pagePath = window.location.pathname.replace(/\/+$/, "")
+      var subPath = "{{.subPath}}"
+      if (subPath && pagePath.endsWith("/" + subPath)) {
+        pagePath = pagePath.slice(0, -subPath.length - 1)
+      }
+      var docUrl = window.location.origin + pagePath
+      var specUrl = docUrl + "/{{.specName}}"
+{{end}}`
+
+const ReDocContent = `{{define "redoc"}}
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{.title}}</title>
+    <style>
+      body
+      {
+        margin: 0;
+        padding: 0;
+      }
+    </style>
+  </head>
+
+  <body>
+    <div id="redoc"></div>
+
+    <script src="{{.cdn}}" charset="UTF-8" crossorigin="anonymous"></script>
+    <script>
+      {{template "specUrl" .}}
+      Redoc.init(specUrl, {}, document.getElementById("redoc"))
+    </script>
+  </body>
+</html>
+{{end}}`
+
+const RapiDocContent = `{{define "rapidoc"}}
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{.title}}</title>
+    <script type="module" src="{{.cdn}}" crossorigin="anonymous"></script>
+  </head>
+
+  <body>
+    <rapi-doc id="rapidoc" render-style="read" show-header="false"></rapi-doc>
+
+    <script>
+      {{template "specUrl" .}}
+      document.getElementById("rapidoc").setAttribute("spec-url", specUrl)
+    </script>
+  </body>
+</html>
+{{end}}`
+
+const ScalarContent = `{{define "scalar"}}
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{.title}}</title>
+  </head>
+
+  <body>
+    <script id="api-reference"></script>
+
+    <script>
+      {{template "specUrl" .}}
+      document.getElementById("api-reference").dataset.url = specUrl
+    </script>
+    <script src="{{.cdn}}" charset="UTF-8" crossorigin="anonymous"></script>
+  </body>
+</html>
+{{end}}`
+
+// OAuth2RedirectContent is the page Swagger UI redirects to after
+// authorization, copied from oauth2-redirect.html of Swagger UI.
+const OAuth2RedirectContent = `<!doctype html>
+<html lang="en-US">
+<head>
+    <title>Swagger UI: OAuth2 Redirect</title>
+</head>
+<body>
+<script>
+    'use strict';
+    function run () {
+        var oauth2 = window.opener.swaggerUIRedirectOauth2;
+        var sentState = oauth2.state;
+        var redirectUrl = oauth2.redirectUrl;
+        var isValid, qp, arr;
+
+        if (/code|token|error/.test(window.location.hash)) {
+            qp = window.location.hash.substring(1).replace('?', '&');
+        } else {
+            qp = location.search.substring(1);
+        }
+
+        arr = qp.split("&");
+        arr.forEach(function (v,i,_arr) { _arr[i] = '"' + v.replace('=', '":"') + '"';});
+        qp = qp ? JSON.parse('{' + arr.join() + '}',
+                function (key, value) {
+                    return key === "" ? value : decodeURIComponent(value);
+                }
+        ) : {};
+
+        isValid = qp.state === sentState;
+
+        if ((
+          oauth2.auth.schema.get("flow") === "accessCode" ||
+          oauth2.auth.schema.get("flow") === "authorizationCode" ||
+          oauth2.auth.schema.get("flow") === "authorization_code"
+        ) && !oauth2.auth.code) {
+            if (!isValid) {
+                oauth2.errCb({
+                    authId: oauth2.auth.name,
+                    source: "auth",
+                    level: "warning",
+                    message: "Authorization may be unsafe, passed state was changed in server. The passed state wasn't returned from auth server."
+                });
+            }
+
+            if (qp.code) {
+                delete oauth2.state;
+                oauth2.auth.code = qp.code;
+                oauth2.callback({auth: oauth2.auth, redirectUrl: redirectUrl});
+            } else {
+                let oauthErrorMsg;
+                if (qp.error) {
+                    oauthErrorMsg = "["+qp.error+"]: " +
+                        (qp.error_description ? qp.error_description+ ". " : "no accessCode received from the server. ") +
+                        (qp.error_uri ? "More info: "+qp.error_uri : "");
+                }
+
+                oauth2.errCb({
+                    authId: oauth2.auth.name,
+                    source: "auth",
+                    level: "error",
+                    message: oauthErrorMsg || "[Authorization failed]: no accessCode received from the server."
+                });
+            }
+        } else {
+            oauth2.callback({auth: oauth2.auth, token: qp, isValid: isValid, redirectUrl: redirectUrl});
+        }
+        window.close();
+    }
+
+    if (document.readyState !== 'loading') {
+        run();
+    } else {
+        document.addEventListener('DOMContentLoaded', function () {
+            run();
+        });
+    }
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,96 @@
+package echoswagger
+
+// filterSpec returns a copy of spec which only contains operations visible
+// to audience, and the definitions, security definitions and tags they use.
+// Definitions not generated, and the ones used by global responses and
+// parameters, are kept.
+func (r *Root) filterSpec(spec Swagger, audience string) Swagger {
+	visible := func(o *Operation) *Operation {
+		if o == nil {
+			return nil
+		}
+		audiences, ok := r.audiences[o]
+		if !ok {
+			return o
+		}
+		for _, a := range audiences {
+			if a == audience {
+				return o
+			}
+		}
+		return nil
+	}
+
+	paths := make(map[string]interface{})
+	var operations []*Operation
+	for k, v := range spec.Paths {
+		p, ok := v.(*Path)
+		if !ok {
+			paths[k] = v
+			continue
+		}
+		np := *p
+		np.Get, np.Put, np.Post = visible(p.Get), visible(p.Put), visible(p.Post)
+		np.Delete, np.Options = visible(p.Delete), visible(p.Options)
+		np.Head, np.Patch = visible(p.Head), visible(p.Patch)
+		ops := []*Operation{np.Get, np.Put, np.Post, np.Delete, np.Options, np.Head, np.Patch}
+		empty := true
+		for _, o := range ops {
+			if o != nil {
+				operations = append(operations, o)
+				empty = false
+			}
+		}
+		if !empty {
+			paths[k] = &np
+		}
+	}
+	spec.Paths = paths
+
+	tags := make(map[string]bool)
+	securities := make(map[string]bool)
+	for _, s := range spec.Security {
+		for name := range s {
+			securities[name] = true
+		}
+	}
+	for _, o := range operations {
+		for _, t := range o.Tags {
+			tags[t] = true
+		}
+		for _, s := range o.Security {
+			for name := range s {
+				securities[name] = true
+			}
+		}
+	}
+
+	var ts []*Tag
+	for _, t := range spec.Tags {
+		if tags[t.Name] {
+			ts = append(ts, t)
+		}
+	}
+	spec.Tags = ts
+
+	sds := make(map[string]*SecurityDefinition)
+	for name, sd := range spec.SecurityDefinitions {
+		if securities[name] {
+			sds[name] = sd
+		}
+	}
+	spec.SecurityDefinitions = sds
+
+	kept := make(map[string]*JSONSchema)
+	for k, v := range spec.Definitions {
+		if !r.generatedDefs[k] {
+			kept[k] = v
+		}
+	}
+	defs := referencedDefinitions([]interface{}{paths, spec.Responses, spec.Parameters, kept}, spec.Definitions)
+	for k, v := range kept {
+		defs[k] = v
+	}
+	spec.Definitions = defs
+	return spec
+}
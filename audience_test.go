@@ -0,0 +1,86 @@
+package echoswagger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudienceSpec(t *testing.T) {
+	type Item struct {
+		Name string
+	}
+	type Order struct {
+		Items []Item
+	}
+	type Report struct {
+		Total int
+	}
+	h := func(c echo.Context) error { return nil }
+
+	e := echo.New()
+	r := New(e, "doc/", nil)
+	r.AddSecurityAPIKey("JWT", "", SecurityInHeader).
+		AddSecurityBasic("Basic", "").
+		AddSecurityAPIKey("Tenant", "", SecurityInQuery).
+		AddAudienceSpec("public").
+		AddAudienceSpec("internal")
+	r.GetRaw().Security = []map[string][]string{{"Tenant": {}}}
+	r.GetRaw().Definitions = map[string]*JSONSchema{"Raw": {Type: "object"}}
+	r.GetRaw().Responses = map[string]*Response{
+		"Report": {Description: "report", Schema: &JSONSchema{Ref: DefPrefix + "Report"}},
+	}
+
+	r.GET("/orders", h).AddResponse(http.StatusOK, "", []Order{}, nil).SetSecurity("JWT")
+	r.GET("/reports", h).AddResponse(http.StatusOK, "", Report{}, nil).
+		SetSecurity("Basic").SetAudiences("internal")
+	g := r.Group("Admin", "/admin").SetAudiences("internal")
+	g.DELETE("/orders", h)
+	g.GET("/health", h).SetAudiences("public", "internal")
+
+	get := func(path string) Swagger {
+		req := httptest.NewRequest(echo.GET, path, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var spec Swagger
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+		return spec
+	}
+
+	full := get("/doc/swagger.json")
+	assert.Len(t, full.Paths, 4)
+	assert.Len(t, full.Definitions, 4)
+	assert.Len(t, full.SecurityDefinitions, 3)
+
+	public := get("/doc/public/swagger.json")
+	assert.Len(t, public.Paths, 2)
+	assert.Contains(t, public.Paths, "/orders")
+	assert.Contains(t, public.Paths, "/admin/health")
+	// Report is used by global responses, and Raw isn't generated.
+	assert.Len(t, public.Definitions, 4)
+	assert.Contains(t, public.Definitions, "Order")
+	assert.Contains(t, public.Definitions, "Item")
+	assert.Contains(t, public.Definitions, "Report")
+	assert.Contains(t, public.Definitions, "Raw")
+	// Tenant is only used by the spec, which is kept.
+	assert.Len(t, public.SecurityDefinitions, 2)
+	assert.Contains(t, public.SecurityDefinitions, "JWT")
+	assert.Contains(t, public.SecurityDefinitions, "Tenant")
+	if assert.Len(t, public.Tags, 1) {
+		assert.Equal(t, "Admin", public.Tags[0].Name)
+	}
+
+	internal := get("/doc/internal/swagger.json")
+	assert.Len(t, internal.Paths, 4)
+	assert.Len(t, internal.Definitions, 4)
+	assert.Len(t, internal.SecurityDefinitions, 3)
+
+	full = get("/doc/swagger.json")
+	assert.Len(t, full.Paths, 4)
+	assert.Len(t, full.Definitions, 4)
+}
@@ -0,0 +1,88 @@
+// Command echoswagger-docs generates a Go file which registers doc comments
+// of a package to echoswagger, so that they're used in the swagger spec
+// without the source at runtime.
+//
+// Usually it's used with go generate, in a file of the package:
+//
+//	//go:generate go run github.com/pangpanglabs/echoswagger/cmd/echoswagger-docs
+//
+// Use `-import github.com/pangpanglabs/echoswagger/v2` with echo v4.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pangpanglabs/echoswagger"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package")
+	output := flag.String("o", "echoswagger_docs.go", "output file name, relative to dir")
+	importPath := flag.String("import", "github.com/pangpanglabs/echoswagger", "import path of echoswagger in the generated file")
+	flag.Parse()
+
+	b, err := generate(*dir, *importPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(*dir, *output), b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(dir, importPath string) ([]byte, error) {
+	pkgName, err := packageName(dir)
+	if err != nil {
+		return nil, err
+	}
+	docs, err := echoswagger.ParseDocs(dir)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(docs))
+	for k := range docs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by echoswagger-docs. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package %s\n\n", pkgName)
+	fmt.Fprintf(buf, "import (\n\t\"reflect\"\n\n\techoswagger %s\n)\n\n", strconv.Quote(importPath))
+	fmt.Fprintf(buf, "type echoswaggerDocs struct{}\n\n")
+	fmt.Fprintf(buf, "func init() {\n")
+	fmt.Fprintf(buf, "\techoswagger.RegisterDocs(reflect.TypeOf(echoswaggerDocs{}).PkgPath(), map[string]string{\n")
+	for _, k := range keys {
+		fmt.Fprintf(buf, "\t\t%s: %s,\n", strconv.Quote(k), strconv.Quote(docs[k]))
+	}
+	fmt.Fprintf(buf, "\t})\n}\n")
+	return format.Source(buf.Bytes())
+}
+
+// packageName returns name of the non-test package in dir.
+func packageName(dir string) (string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	for name := range pkgs {
+		return name, nil
+	}
+	return "", fmt.Errorf("no Go files in %s", dir)
+}
@@ -12,6 +12,10 @@ func toSwaggerType(t reflect.Type) (string, string) {
 	if t == reflect.TypeOf(time.Time{}) {
 		return "string", "date-time"
 	}
+	// Type "file" is only allowed in formData, see toParamType.
+	if t == fileType {
+		return "string", "binary"
+	}
 	switch t.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
 		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uintptr:
@@ -39,6 +43,18 @@ func toSwaggerType(t reflect.Type) (string, string) {
 	}
 }
 
+// toParamType returns type、format of a parameter in, like toSwaggerType,
+// uploaded files are type "file" in formData.
+func toParamType(t reflect.Type, in ParamInType) (string, string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == fileType && in == ParamInFormData {
+		return "file", ""
+	}
+	return toSwaggerType(t)
+}
+
 // toSwaggerPath returns path in swagger format
 func toSwaggerPath(path string) string {
 	var params []string
@@ -57,6 +73,17 @@ func toSwaggerPath(path string) string {
 	return connectPath(path)
 }
 
+// toSwaggerWildcardPath returns path in swagger format like toSwaggerPath,
+// with trailing "*" as path parameter wildcardParam, which must be added
+// to the operation.
+func toSwaggerWildcardPath(path string) string {
+	path = toSwaggerPath(path)
+	if strings.HasSuffix(path, "*") {
+		path = path[:len(path)-1] + "{" + wildcardParam + "}"
+	}
+	return path
+}
+
 func converter(t reflect.Type) func(s string) (interface{}, error) {
 	st, sf := toSwaggerType(t)
 	if st == "integer" && sf == "int32" {
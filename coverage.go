@@ -0,0 +1,135 @@
+package echoswagger
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo"
+)
+
+// CoverageReport shows how well the spec is documented.
+// Operations are listed as "GET /users/{id}", parameters as
+// "GET /users/{id} id", properties as "User.name", and echo routes as
+// "GET /users/:id".
+type CoverageReport struct {
+	// Operations is the count of operations in spec.
+	Operations int `json:"operations"`
+	// Documented is the count of operations without any missing doc,
+	// including their parameters.
+	Documented          int      `json:"documented"`
+	MissingSummary      []string `json:"missingSummary"`
+	MissingDescription  []string `json:"missingDescription"`
+	MissingOperationId  []string `json:"missingOperationId"`
+	DefaultResponseOnly []string `json:"defaultResponseOnly"`
+
+	// Parameters is the count of parameters of operations.
+	Parameters            int      `json:"parameters"`
+	UndescribedParameters []string `json:"undescribedParameters"`
+	// Properties is the count of properties of definitions,
+	// except those referencing other definitions.
+	Properties            int      `json:"properties"`
+	UndescribedProperties []string `json:"undescribedProperties"`
+	// UndocumentedRoutes are echo routes not created by ApiRouter,
+	// see `SetDiscovery`.
+	UndocumentedRoutes []string `json:"undocumentedRoutes"`
+}
+
+func (r *Root) coverageHandler(c echo.Context) error {
+	if !r.ui.Coverage {
+		return echo.ErrNotFound
+	}
+	report, err := r.Coverage()
+	if err != nil {
+		return c.String(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// coverage generates CoverageReport of spec. Operations in generated are
+// created from routes, whose only default response is synthesized.
+func (r *Root) coverage(spec Swagger, generated map[string]bool) *CoverageReport {
+	report := &CoverageReport{
+		MissingSummary:        []string{},
+		MissingDescription:    []string{},
+		MissingOperationId:    []string{},
+		DefaultResponseOnly:   []string{},
+		UndescribedParameters: []string{},
+		UndescribedProperties: []string{},
+		UndocumentedRoutes:    []string{},
+	}
+
+	paths := make([]string, 0, len(spec.Paths))
+	for k := range spec.Paths {
+		paths = append(paths, k)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		p, ok := spec.Paths[path].(*Path)
+		if !ok {
+			continue
+		}
+		for _, method := range swaggerMethods {
+			o := p.operation(method)
+			if o == nil {
+				continue
+			}
+			name := method + " " + path
+			report.Operations++
+			documented := true
+			missing := func(list *[]string, ok bool) {
+				if !ok {
+					*list = append(*list, name)
+					documented = false
+				}
+			}
+			missing(&report.MissingSummary, o.Summary != "")
+			missing(&report.MissingDescription, o.Description != "")
+			missing(&report.MissingOperationId, o.OperationID != "")
+			_, defaultOnly := o.Responses["default"]
+			missing(&report.DefaultResponseOnly, !generated[name] || !defaultOnly || len(o.Responses) > 1)
+			for _, param := range o.Parameters {
+				report.Parameters++
+				if param.Description == "" {
+					report.UndescribedParameters = append(report.UndescribedParameters, name+" "+param.Name)
+					documented = false
+				}
+			}
+			if documented {
+				report.Documented++
+			}
+		}
+	}
+
+	defs := make([]string, 0, len(spec.Definitions))
+	for k := range spec.Definitions {
+		defs = append(defs, k)
+	}
+	sort.Strings(defs)
+	for _, def := range defs {
+		props := spec.Definitions[def].Properties
+		names := make([]string, 0, len(props))
+		for k := range props {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			// Siblings of $ref are ignored, so properties referencing
+			// definitions can't be described.
+			if props[name].Ref != "" {
+				continue
+			}
+			report.Properties++
+			if props[name].Description == "" {
+				report.UndescribedProperties = append(report.UndescribedProperties, def+"."+name)
+			}
+		}
+	}
+
+	for _, route := range r.undocumented {
+		report.UndocumentedRoutes = append(report.UndocumentedRoutes, routeKey(route.Method, route.Path))
+	}
+	return report
+}
+
+// swaggerMethods are methods of operations in the order of `Path`.
+var swaggerMethods = []string{echo.GET, echo.PUT, echo.POST, echo.DELETE, echo.OPTIONS, echo.HEAD, echo.PATCH}
@@ -0,0 +1,92 @@
+package echoswagger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoverage(t *testing.T) {
+	type Owner struct {
+		Name string `json:"name" swagger:"desc(Name of owner)"`
+	}
+	type Pet struct {
+		ID    int64  `json:"id" swagger:"desc(ID of pet)"`
+		Name  string `json:"name"`
+		Owner Owner  `json:"owner"`
+	}
+	type Query struct {
+		Limit int `query:"limit"`
+	}
+
+	e := echo.New()
+	r := New(e, "doc/", nil)
+	r.GET("/pets/:id", testHandler).
+		AddParamPath(0, "id", "ID of pet").
+		AddResponse(http.StatusOK, "pet", &Pet{}, nil).
+		SetSummary("Get pet").
+		SetDescription("Get pet by ID").
+		SetOperationId("getPet")
+	r.GET("/pets", testHandler).
+		AddParamQueryNested(&Query{}).
+		SetSummary("List pets")
+	r.DELETE("/debug", testHandler).Hidden()
+	e.PUT("/legacy/:id", testHandler)
+
+	req := httptest.NewRequest(echo.GET, "/doc/coverage.json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	r.SetUI(UISetting{Coverage: true})
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var served CoverageReport
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+
+	report, err := r.Coverage()
+	assert.NoError(t, err)
+	assert.Equal(t, &CoverageReport{
+		Operations:            2,
+		Documented:            1,
+		MissingSummary:        []string{},
+		MissingDescription:    []string{"GET /pets"},
+		MissingOperationId:    []string{"GET /pets"},
+		DefaultResponseOnly:   []string{"GET /pets"},
+		Parameters:            2,
+		UndescribedParameters: []string{"GET /pets limit"},
+		Properties:            3,
+		UndescribedProperties: []string{"Pet.name"},
+		UndocumentedRoutes:    []string{"PUT /legacy/:id"},
+	}, report)
+	assert.Equal(t, report, &served)
+
+	r.SetUI(UISetting{})
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCoverageDeclaredDefault(t *testing.T) {
+	spec, err := LoadSpec([]byte(`{
+  "swagger": "2.0",
+  "paths": {
+    "/pets": {"get": {"operationId": "listPets", "responses": {"default": {"description": "pets or error"}}}}
+  }
+}`))
+	assert.NoError(t, err)
+	r := New(echo.New(), "doc/", nil).SetRaw(spec)
+	r.GET("/health", testHandler)
+	r.OnSpec(func(s *Swagger) error {
+		return nil
+	})
+
+	report, err := r.Coverage()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET /health"}, report.DefaultResponseOnly)
+}
@@ -0,0 +1,202 @@
+package echoswagger
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo"
+)
+
+// DiscoveryMode is the way to handle routes registered directly on echo,
+// which are not created by ApiRouter.
+type DiscoveryMode int
+
+const (
+	// DiscoveryIgnore leaves them out of spec. It's the default.
+	DiscoveryIgnore DiscoveryMode = iota
+	// DiscoveryWarn logs a warning for each of them by `Echo#Logger`
+	// when spec is generated, level of the logger should allow warnings.
+	DiscoveryWarn
+	// DiscoveryInclude adds minimal operations of them to spec,
+	// with path parameters inferred from path and a default response.
+	DiscoveryInclude
+)
+
+// echoHandlers are names of handlers registered by echo itself,
+// e.g. routes created by `Group#Use()` to reach middleware of the group,
+// other routes of echo like `Echo#Static()` are discovered.
+var echoHandlers = func() map[string]bool {
+	names := make(map[string]bool)
+	for _, h := range []echo.HandlerFunc{echo.NotFoundHandler, echo.MethodNotAllowedHandler} {
+		names[handlerName(h)] = true
+	}
+	e := echo.New()
+	e.Group("/g").Use(func(next echo.HandlerFunc) echo.HandlerFunc { return next })
+	for _, route := range e.Routes() {
+		names[route.Name] = true
+	}
+	return names
+}()
+
+// routeSet is a set of routes created by Roots sharing an echo instance,
+// including routes of docs, it's safe for concurrent use.
+type routeSet struct {
+	sync.Mutex
+	keys map[string]bool
+	// roots is the number of Roots using the set before their specs are generated.
+	roots int
+}
+
+// routeSets are routeSets of echo instances, so Roots sharing an echo
+// don't discover routes of each other. A routeSet is removed when specs of
+// all Roots using it are generated, then the echo isn't referred.
+var routeSets = struct {
+	sync.Mutex
+	m map[*echo.Echo]*routeSet
+}{m: make(map[*echo.Echo]*routeSet)}
+
+// ownedRoutes returns routeSet of e for a new Root.
+func ownedRoutes(e *echo.Echo) *routeSet {
+	routeSets.Lock()
+	defer routeSets.Unlock()
+	s, ok := routeSets.m[e]
+	if !ok {
+		s = &routeSet{keys: make(map[string]bool)}
+		routeSets.m[e] = s
+	}
+	s.roots++
+	return s
+}
+
+// releaseRoutes is called when spec of a Root using routeSet of e is
+// generated, the Root keeps the set.
+func releaseRoutes(e *echo.Echo) {
+	routeSets.Lock()
+	defer routeSets.Unlock()
+	s, ok := routeSets.m[e]
+	if !ok {
+		return
+	}
+	if s.roots--; s.roots <= 0 {
+		delete(routeSets.m, e)
+	}
+}
+
+func (s *routeSet) add(method, path string) {
+	s.Lock()
+	s.keys[routeKey(method, path)] = true
+	s.Unlock()
+}
+
+func (s *routeSet) has(method, path string) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.keys[routeKey(method, path)]
+}
+
+// discover handles routes of echo which are not created by ApiRouter
+// or for docs, it's called before cleanUp.
+func (r *Root) discover() {
+	r.undocumented = r.undocumentedRoutes()
+	for _, route := range r.undocumented {
+		switch r.discovery {
+		case DiscoveryWarn:
+			r.echo.Logger.Warnf("echoswagger: route %s %s isn't documented", route.Method, route.Path)
+		case DiscoveryInclude:
+			r.addDiscovered(route)
+		}
+	}
+}
+
+// undocumentedRoutes returns routes of echo which are not created by
+// any Root sharing the echo, sorted by method and path.
+func (r *Root) undocumentedRoutes() []*echo.Route {
+	routes := r.echo.Routes()
+	sort.Slice(routes, func(i, j int) bool {
+		return routeKey(routes[i].Method, routes[i].Path) < routeKey(routes[j].Method, routes[j].Path)
+	})
+	known := make(map[string]bool)
+	var undocumented []*echo.Route
+	for _, route := range routes {
+		key := routeKey(route.Method, route.Path)
+		if known[key] || r.owned.has(route.Method, route.Path) ||
+			!isValidMethod(route.Method) || echoHandlers[route.Name] {
+			continue
+		}
+		known[key] = true
+		undocumented = append(undocumented, route)
+	}
+	return undocumented
+}
+
+// addDiscovered adds operation of route to spec, if the path
+// doesn't have an operation of the method.
+func (r *Root) addDiscovered(route *echo.Route) {
+	path := toSwaggerWildcardPath(route.Path)
+	p, ok := r.spec.Paths[path].(*Path)
+	if !ok {
+		p = &Path{}
+	}
+	if p.operation(route.Method) != nil {
+		return
+	}
+	o := &Operation{
+		Responses: map[string]*Response{
+			"default": {Description: "successful operation"},
+		},
+	}
+	for _, name := range pathParams(route.Path) {
+		o.Parameters = append(o.Parameters, &Parameter{
+			Name:     name,
+			In:       string(ParamInPath),
+			Type:     "string",
+			Required: true,
+		})
+	}
+	if r.conf.introspection {
+		o.Extensions = map[string]interface{}{"x-handler": route.Name}
+	}
+	o.addHandlerDoc(route.Name)
+	r.generated[o] = true
+	p.oprationAssign(route.Method, o)
+	r.spec.Paths[path] = p
+}
+
+// operation returns operation of method.
+func (p *Path) operation(method string) *Operation {
+	switch method {
+	case echo.GET:
+		return p.Get
+	case echo.POST:
+		return p.Post
+	case echo.PUT:
+		return p.Put
+	case echo.DELETE:
+		return p.Delete
+	case echo.OPTIONS:
+		return p.Options
+	case echo.HEAD:
+		return p.Head
+	case echo.PATCH:
+		return p.Patch
+	}
+	return nil
+}
+
+// pathParams returns names of parameters in echo path.
+func pathParams(path string) []string {
+	var names []string
+	for _, s := range strings.Split(path, "/") {
+		if strings.HasPrefix(s, ":") {
+			names = append(names, s[1:])
+		} else if strings.HasSuffix(s, "*") {
+			names = append(names, wildcardParam)
+		}
+	}
+	return names
+}
+
+func routeKey(method, path string) string {
+	return method + " " + path
+}
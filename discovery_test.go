@@ -0,0 +1,127 @@
+package echoswagger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func prepareDiscovery(mode DiscoveryMode) (ApiRoot, *bytes.Buffer) {
+	e := echo.New()
+	buf := new(bytes.Buffer)
+	e.Logger.SetOutput(buf)
+	e.Logger.SetLevel(log.WARN)
+	r := New(e, "doc/", nil).SetDiscovery(mode)
+	r.SetUI(UISetting{SubPaths: map[string]UIRenderer{"redoc": UIReDoc}})
+	r.AddSecurityOAuth2("OAuth2", "", OAuth2FlowImplicit, "http://example.com/auth", "", nil)
+
+	r.GET("/users/:id", testHandler).SetSummary("Get user")
+	r.GET("/debug", testHandler).Hidden()
+	r.Any("/any", testHandler)
+	g := r.Group("Legacy", "/legacy")
+	g.GET("/documented", testHandler)
+
+	eg := g.EchoGroup()
+	eg.Use(func(next echo.HandlerFunc) echo.HandlerFunc { return next })
+	eg.GET("/items/:id/files/*", testHandler)
+	e.DELETE("/users/:id", testHandler)
+	e.File("/favicon.ico", "favicon.ico")
+	e.CONNECT("/tunnel", testHandler)
+	return r, buf
+}
+
+func TestDiscovery(t *testing.T) {
+	t.Run("Include", func(t *testing.T) {
+		r, _ := prepareDiscovery(DiscoveryInclude)
+		spec, err := r.(*Root).GetSpec(nil, "/doc")
+		assert.NoError(t, err)
+
+		assert.Len(t, spec.Paths, 5)
+		user := spec.Paths["/users/{id}"].(*Path)
+		assert.Equal(t, "Get user", user.Get.Summary)
+		if assert.NotNil(t, user.Delete) {
+			assert.Equal(t, []*Parameter{{Name: "id", In: "path", Type: "string", Required: true}}, user.Delete.Parameters)
+			assert.Equal(t, "successful operation", user.Delete.Responses["default"].Description)
+		}
+		if assert.Contains(t, spec.Paths, "/legacy/items/{id}/files/{filepath}") {
+			o := spec.Paths["/legacy/items/{id}/files/{filepath}"].(*Path).Get
+			if assert.Len(t, o.Parameters, 2) {
+				assert.Equal(t, "id", o.Parameters[0].Name)
+				assert.Equal(t, "filepath", o.Parameters[1].Name)
+			}
+			assert.Empty(t, o.Tags)
+		}
+		assert.Contains(t, spec.Paths, "/legacy/documented")
+		assert.Contains(t, spec.Paths, "/favicon.ico")
+		assert.Contains(t, spec.Paths, "/any")
+		assert.NotContains(t, spec.Paths, "/debug")
+		assert.NotContains(t, spec.Paths, "/tunnel")
+		assert.NotContains(t, spec.Paths, "/legacy")
+	})
+
+	t.Run("Warn", func(t *testing.T) {
+		r, buf := prepareDiscovery(DiscoveryWarn)
+		spec, err := r.(*Root).GetSpec(nil, "/doc")
+		assert.NoError(t, err)
+
+		assert.Len(t, spec.Paths, 3)
+		assert.Nil(t, spec.Paths["/users/{id}"].(*Path).Delete)
+		log := buf.String()
+		assert.Contains(t, log, "echoswagger: route DELETE /users/:id isn't documented")
+		assert.Contains(t, log, "echoswagger: route GET /legacy/items/:id/files/* isn't documented")
+		assert.Contains(t, log, "echoswagger: route GET /favicon.ico isn't documented")
+		assert.NotContains(t, log, "/legacy isn't documented")
+		assert.NotContains(t, log, "/doc")
+		assert.NotContains(t, log, "/debug")
+		assert.NotContains(t, log, "/tunnel")
+	})
+
+	t.Run("Ignore", func(t *testing.T) {
+		r, buf := prepareDiscovery(DiscoveryIgnore)
+		spec, err := r.(*Root).GetSpec(nil, "/doc")
+		assert.NoError(t, err)
+
+		assert.Len(t, spec.Paths, 3)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("SharedEcho", func(t *testing.T) {
+		e := echo.New()
+		v1 := New(e, "/doc", &Info{Title: "v1"}).SetDiscovery(DiscoveryInclude)
+		v2 := New(e, "/v2/doc", &Info{Title: "v2"}).SetDiscovery(DiscoveryInclude)
+		v1.AddSpec("v2", v2)
+		v1.GET("/users", testHandler)
+		v2.Group("Users", "/v2/users").GET("", testHandler)
+		e.GET("/health", testHandler)
+
+		spec, err := v2.(*Root).GetSpec(nil, "/v2/doc")
+		assert.NoError(t, err)
+		assert.Len(t, spec.Paths, 2)
+		assert.Contains(t, spec.Paths, "/v2/users")
+		assert.Contains(t, spec.Paths, "/health")
+
+		// Routes of v2 are still known after its spec is generated.
+		v1.GET("/orders", testHandler)
+		spec, err = v1.(*Root).GetSpec(nil, "/doc")
+		assert.NoError(t, err)
+		assert.Len(t, spec.Paths, 3)
+		assert.Contains(t, spec.Paths, "/users")
+		assert.Contains(t, spec.Paths, "/orders")
+		assert.Contains(t, spec.Paths, "/health")
+
+		// The echo isn't referred after specs of all Roots are generated.
+		routeSets.Lock()
+		assert.NotContains(t, routeSets.m, e)
+		routeSets.Unlock()
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		r := New(echo.New(), "doc/", nil)
+		assert.PanicsWithValue(t, "echoswagger: invalid discovery mode", func() {
+			r.SetDiscovery(DiscoveryMode(-1))
+		})
+	})
+}
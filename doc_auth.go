@@ -0,0 +1,148 @@
+package echoswagger
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo"
+)
+
+// DisableEnv is the environment variable which disables docs created by
+// NewFromEnv if it's set to true, e.g. `ECHOSWAGGER_DISABLED=true`.
+const DisableEnv = "ECHOSWAGGER_DISABLED"
+
+// docTokenCookie keeps the token passed by query, so that the doc page
+// can load spec with it.
+const docTokenCookie = "echoswagger_token"
+
+// DocAuth protects doc endpoints, including the doc page, specs and
+// the OAuth2 redirect page. Zero value allows all requests.
+type DocAuth struct {
+	// Disabled makes doc endpoints respond 404.
+	Disabled bool
+	// AllowCIDRs only allows requests from these networks, e.g. "10.0.0.0/8".
+	// Address of the request is read from `Request.RemoteAddr`.
+	AllowCIDRs []string
+	// BasicAuth allows requests with one of these usernames and passwords.
+	BasicAuth map[string]string
+	// Realm of basic auth, "Documentation" by default.
+	Realm string
+	// Token allows requests with it as bearer token in `Authorization` header,
+	// or in query "token", which is kept in a cookie for the following requests.
+	Token string
+}
+
+// NewFromEnv creates ApiRoot like New, or a NopRoot like NewNop if
+// environment variable DisableEnv is true.
+func NewFromEnv(e *echo.Echo, docPath string, i *Info, m ...echo.MiddlewareFunc) ApiRoot {
+	if disabled, _ := strconv.ParseBool(os.Getenv(DisableEnv)); disabled {
+		return NewNop(e)
+	}
+	return New(e, docPath, i, m...)
+}
+
+// parseCIDRs parses networks of DocAuth.
+func (a DocAuth) parseCIDRs() ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, s := range a.AllowCIDRs {
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+// docAuthMiddleware applies DocAuth of UISetting to doc endpoints.
+func (r *Root) docAuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		auth := r.ui.Auth
+		if auth.Disabled {
+			return echo.ErrNotFound
+		}
+		if len(r.docNets) > 0 && !containsIP(r.docNets, c.Request().RemoteAddr) {
+			return echo.NewHTTPError(http.StatusForbidden)
+		}
+		if len(auth.BasicAuth) == 0 && auth.Token == "" {
+			return next(c)
+		}
+		if auth.checkBasicAuth(c) || auth.checkToken(c, r.docPath) {
+			return next(c)
+		}
+		if len(auth.BasicAuth) > 0 {
+			realm := auth.Realm
+			if realm == "" {
+				realm = "Documentation"
+			}
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Basic realm="+strconv.Quote(realm))
+		}
+		return echo.ErrUnauthorized
+	}
+}
+
+func (a DocAuth) checkBasicAuth(c echo.Context) bool {
+	user, pass, ok := c.Request().BasicAuth()
+	if !ok {
+		return false
+	}
+	p, ok := a.BasicAuth[user]
+	return ok && secureCompare(p, pass)
+}
+
+func (a DocAuth) checkToken(c echo.Context, docPath string) bool {
+	if a.Token == "" {
+		return false
+	}
+	h := c.Request().Header.Get(echo.HeaderAuthorization)
+	if strings.HasPrefix(h, "Bearer ") && secureCompare(a.Token, strings.TrimPrefix(h, "Bearer ")) {
+		return true
+	}
+	if cookie, err := c.Cookie(docTokenCookie); err == nil && secureCompare(a.Token, cookie.Value) {
+		return true
+	}
+	if token := c.QueryParam("token"); token != "" && secureCompare(a.Token, token) {
+		c.SetCookie(&http.Cookie{
+			Name:     docTokenCookie,
+			Value:    token,
+			Path:     cookiePath(docPath),
+			HttpOnly: true,
+			Secure:   c.IsTLS() || c.Scheme() == "https",
+			SameSite: http.SameSiteStrictMode,
+		})
+		return true
+	}
+	return false
+}
+
+func cookiePath(docPath string) string {
+	if p := removeTrailingSlash(connectPath(docPath)); p != "" {
+		return p
+	}
+	return "/"
+}
+
+func secureCompare(expected, actual string) bool {
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
+}
+
+func containsIP(nets []*net.IPNet, addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package echoswagger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocAuth(t *testing.T) {
+	serve := func(e *echo.Echo, req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		e := echo.New()
+		New(e, "doc/", nil).SetUI(UISetting{Auth: DocAuth{Disabled: true}})
+		rec := serve(e, httptest.NewRequest(echo.GET, "/doc/swagger.json", nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("AllowCIDRs", func(t *testing.T) {
+		e := echo.New()
+		New(e, "doc/", nil).SetUI(UISetting{Auth: DocAuth{AllowCIDRs: []string{"10.0.0.0/8", "::1/128"}}})
+		req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+		req.RemoteAddr = "10.1.2.3:1234"
+		assert.Equal(t, http.StatusOK, serve(e, req).Code)
+		req.RemoteAddr = "[::1]:1234"
+		assert.Equal(t, http.StatusOK, serve(e, req).Code)
+		req.RemoteAddr = "192.168.0.1:1234"
+		req.Header.Set("X-Forwarded-For", "10.1.2.3")
+		assert.Equal(t, http.StatusForbidden, serve(e, req).Code)
+
+		assert.Panics(t, func() {
+			New(echo.New(), "doc/", nil).SetUI(UISetting{Auth: DocAuth{AllowCIDRs: []string{"10.0.0.0"}}})
+		})
+	})
+
+	t.Run("BasicAuth", func(t *testing.T) {
+		e := echo.New()
+		New(e, "doc/", nil).SetUI(UISetting{Auth: DocAuth{BasicAuth: map[string]string{"admin": "secret"}}})
+		req := httptest.NewRequest(echo.GET, "/doc/", nil)
+		rec := serve(e, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, `Basic realm="Documentation"`, rec.Header().Get(echo.HeaderWWWAuthenticate))
+		req.SetBasicAuth("admin", "wrong")
+		assert.Equal(t, http.StatusUnauthorized, serve(e, req).Code)
+		req.SetBasicAuth("admin", "secret")
+		assert.Equal(t, http.StatusOK, serve(e, req).Code)
+	})
+
+	t.Run("Token", func(t *testing.T) {
+		e := echo.New()
+		New(e, "doc/", nil).SetUI(UISetting{Auth: DocAuth{Token: "t0ken"}})
+		assert.Equal(t, http.StatusUnauthorized, serve(e, httptest.NewRequest(echo.GET, "/doc/", nil)).Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(e, httptest.NewRequest(echo.GET, "/doc/?token=bad", nil)).Code)
+
+		req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer t0ken")
+		assert.Equal(t, http.StatusOK, serve(e, req).Code)
+
+		rec := serve(e, httptest.NewRequest(echo.GET, "/doc/?token=t0ken", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		cookies := rec.Result().Cookies()
+		if assert.Len(t, cookies, 1) {
+			assert.Equal(t, "/doc", cookies[0].Path)
+			assert.False(t, cookies[0].Secure)
+			req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+			req.AddCookie(cookies[0])
+			assert.Equal(t, http.StatusOK, serve(e, req).Code)
+		}
+
+		// The cookie given over HTTPS isn't sent over HTTP.
+		req = httptest.NewRequest(echo.GET, "/doc/?token=t0ken", nil)
+		req.Header.Set(echo.HeaderXForwardedProto, "https")
+		rec = serve(e, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if cookies := rec.Result().Cookies(); assert.Len(t, cookies, 1) {
+			assert.True(t, cookies[0].Secure)
+		}
+	})
+}
+
+func TestNewFromEnv(t *testing.T) {
+	defer os.Unsetenv(DisableEnv)
+
+	os.Setenv(DisableEnv, "true")
+	e := echo.New()
+	r := NewFromEnv(e, "doc/", nil)
+	assert.IsType(t, &NopRoot{}, r)
+	assert.Len(t, e.Routes(), 0)
+
+	os.Setenv(DisableEnv, "false")
+	r = NewFromEnv(echo.New(), "doc/", nil)
+	assert.IsType(t, &Root{}, r)
+}
@@ -0,0 +1,189 @@
+package echoswagger
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// docs holds doc comments registered by RegisterDocs, keyed by
+// "<package path>.<name>".
+var docs = struct {
+	sync.RWMutex
+	m map[string]string
+}{m: make(map[string]string)}
+
+// RegisterDocs registers doc comments of the package with pkgPath.
+// Keys of d are "Type", "Type.Field", "Func" or "Type.Method", values are
+// the comments without comment markers, as returned by ParseDocs.
+//
+// Registered comments are used as description of definitions, properties,
+// parameters and headers, and as summary and description of operations
+// whose handler is a top-level function or method, unless they're set
+// explicitly. It's usually called by the file generated by
+// `github.com/pangpanglabs/echoswagger/cmd/echoswagger-docs`.
+func RegisterDocs(pkgPath string, d map[string]string) {
+	docs.Lock()
+	defer docs.Unlock()
+	for k, v := range d {
+		docs.m[pkgPath+"."+k] = v
+	}
+}
+
+// LoadDocs parses Go source files in dir, which is the directory of package
+// with pkgPath, and registers their doc comments.
+// It requires the source to be available at runtime, prefer a generated
+// file with `echoswagger-docs` otherwise.
+func LoadDocs(pkgPath, dir string) error {
+	d, err := ParseDocs(dir)
+	if err != nil {
+		return err
+	}
+	RegisterDocs(pkgPath, d)
+	return nil
+}
+
+// ParseDocs returns doc comments of top-level types, struct fields,
+// functions and methods declared in Go source files in dir,
+// test files are excluded. See RegisterDocs for format of result.
+func ParseDocs(dir string) (map[string]string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]string)
+	add := func(key string, groups ...*ast.CommentGroup) {
+		for _, g := range groups {
+			if t := strings.TrimSpace(g.Text()); t != "" {
+				r[key] = t
+				return
+			}
+		}
+	}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch d := decl.(type) {
+				case *ast.FuncDecl:
+					name := d.Name.Name
+					if d.Recv != nil && len(d.Recv.List) > 0 {
+						name = recvTypeName(d.Recv.List[0].Type) + "." + name
+					}
+					add(name, d.Doc)
+				case *ast.GenDecl:
+					if d.Tok != token.TYPE {
+						continue
+					}
+					for _, spec := range d.Specs {
+						ts := spec.(*ast.TypeSpec)
+						if len(d.Specs) == 1 {
+							add(ts.Name.Name, ts.Doc, d.Doc)
+						} else {
+							add(ts.Name.Name, ts.Doc)
+						}
+						st, ok := ts.Type.(*ast.StructType)
+						if !ok {
+							continue
+						}
+						for _, field := range st.Fields.List {
+							for _, n := range field.Names {
+								add(ts.Name.Name+"."+n.Name, field.Doc, field.Comment)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return r, nil
+}
+
+func recvTypeName(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.StarExpr:
+		return recvTypeName(t.X)
+	case *ast.ParenExpr:
+		return recvTypeName(t.X)
+	case *ast.IndexExpr:
+		return recvTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+func lookupDoc(key string) string {
+	docs.RLock()
+	defer docs.RUnlock()
+	return docs.m[key]
+}
+
+// typeDoc returns doc comment of named type t.
+func typeDoc(t reflect.Type) string {
+	if t.Name() == "" || t.PkgPath() == "" {
+		return ""
+	}
+	return lookupDoc(t.PkgPath() + "." + t.Name())
+}
+
+// fieldDoc returns doc comment of field f declared in named struct type owner.
+func fieldDoc(owner reflect.Type, f reflect.StructField) string {
+	if owner == nil || owner.Name() == "" || owner.PkgPath() == "" {
+		return ""
+	}
+	return lookupDoc(owner.PkgPath() + "." + owner.Name() + "." + f.Name)
+}
+
+// handlerDoc returns doc comment of the handler named name, which is
+// the name of a function from runtime, e.g. "github.com/a/b.Handler"
+// or "github.com/a/b.(*Controller).Handler-fm". Dots in the last element
+// of package path are escaped as "%2e" by runtime, e.g. "gopkg.in/a/b%2ev2.Handler",
+// so the package path ends at the first dot after the last slash.
+func handlerDoc(name string) string {
+	name = strings.TrimSuffix(name, "-fm")
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	if dot < 0 {
+		return ""
+	}
+	dot += slash + 1
+	pkgPath, fn := strings.Replace(name[:dot], "%2e", ".", -1), name[dot+1:]
+	fn = strings.NewReplacer("(", "", ")", "", "*", "").Replace(fn)
+	return lookupDoc(pkgPath + "." + fn)
+}
+
+// splitDoc splits a doc comment into its first paragraph, joined into a
+// single line, and the rest.
+func splitDoc(doc string) (string, string) {
+	var summary, desc string
+	if i := strings.Index(doc, "\n\n"); i >= 0 {
+		summary, desc = doc[:i], strings.TrimSpace(doc[i+2:])
+	} else {
+		summary = doc
+	}
+	return strings.Join(strings.Fields(summary), " "), desc
+}
+
+// addHandlerDoc sets summary and description of operation from doc comment
+// of its handler, if they're not set.
+func (o *Operation) addHandlerDoc(handler string) {
+	doc := handlerDoc(handler)
+	if doc == "" {
+		return
+	}
+	summary, desc := splitDoc(doc)
+	if o.Summary == "" {
+		o.Summary = summary
+	}
+	if o.Description == "" {
+		o.Description = desc
+	}
+}
@@ -0,0 +1,142 @@
+package echoswagger
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+// docPet is a pet in the store.
+type docPet struct {
+	// Id of the pet.
+	Id   int64    `json:"id"`
+	Name string   `json:"name" swagger:"desc(Name from tag)"` // Name of the pet.
+	Tags []string `json:"tags" query:"tags"`
+}
+
+type docController struct{}
+
+func (docController) find(c echo.Context) error { return nil }
+
+func docCreatePet(c echo.Context) error { return nil }
+
+func TestParseDocs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "echoswagger")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	src := `package pets
+
+// Pet is a pet.
+type Pet struct {
+	// Id of the pet.
+	Id   int64
+	Name string // Name of the pet.
+	Tags []string
+}
+
+type (
+	// Store sells pets.
+	Store struct{}
+	Empty struct{}
+)
+
+// FindPets finds pets.
+//
+// Pets are sorted by id.
+func FindPets() {}
+
+// Get gets a pet.
+func (s *Store) Get() {}
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "pets.go"), []byte(src), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "pets_test.go"), []byte("package pets\n\n// Test is ignored.\nfunc Test() {}\n"), 0644))
+
+	d, err := ParseDocs(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"Pet":       "Pet is a pet.",
+		"Pet.Id":    "Id of the pet.",
+		"Pet.Name":  "Name of the pet.",
+		"Store":     "Store sells pets.",
+		"FindPets":  "FindPets finds pets.\n\nPets are sorted by id.",
+		"Store.Get": "Get gets a pet.",
+	}, d)
+
+	_, err = ParseDocs(filepath.Join(dir, "none"))
+	assert.Error(t, err)
+}
+
+// saveDocs saves registered docs and returns the function restoring them.
+func saveDocs() func() {
+	docs.Lock()
+	saved := make(map[string]string, len(docs.m))
+	for k, v := range docs.m {
+		saved[k] = v
+	}
+	docs.Unlock()
+	return func() {
+		docs.Lock()
+		docs.m = saved
+		docs.Unlock()
+	}
+}
+
+func TestApplyDocs(t *testing.T) {
+	defer saveDocs()()
+	RegisterDocs("github.com/pangpanglabs/echoswagger", map[string]string{
+		"docPet":             "docPet is a pet in the store.",
+		"docPet.Id":          "Id of the pet.",
+		"docPet.Name":        "Name of the pet.",
+		"docPet.Tags":        "Tags of the pet.",
+		"docController.find": "find finds pets.\nBy tags.\n\nPets are sorted by id.",
+		"docCreatePet":       "docCreatePet creates a pet.",
+	})
+
+	r := prepareApiRoot()
+	var c docController
+	r.GET("/pets", c.find).
+		AddParamQueryNested(&docPet{}).
+		AddResponse(200, "", nil, &docPet{})
+	r.POST("/pets", docCreatePet).
+		AddParamBody(&docPet{}, "body", "", true).
+		SetSummary("Create pet")
+
+	e := r.(*Root).echo
+	s, err := r.(*Root).GetSpec(e.NewContext(nil, nil), "/doc")
+	assert.NoError(t, err)
+
+	def := s.Definitions["docPet"]
+	assert.Equal(t, "docPet is a pet in the store.", def.Description)
+	assert.Equal(t, "Id of the pet.", def.Properties["id"].Description)
+	assert.Equal(t, "Name from tag", def.Properties["name"].Description)
+
+	get := s.Paths["/pets"].(*Path).Get
+	assert.Equal(t, "find finds pets. By tags.", get.Summary)
+	assert.Equal(t, "Pets are sorted by id.", get.Description)
+	assert.Equal(t, "Tags of the pet.", get.Parameters[2].Description)
+	assert.Equal(t, "Id of the pet.", get.Responses["200"].Headers["id"].Description)
+	assert.Equal(t, "Name from tag", get.Responses["200"].Headers["name"].Description)
+
+	post := s.Paths["/pets"].(*Path).Post
+	assert.Equal(t, "Create pet", post.Summary)
+	assert.Equal(t, "", post.Description)
+}
+
+func TestHandlerDoc(t *testing.T) {
+	defer saveDocs()()
+	RegisterDocs("gopkg.in/foo/bar.v2", map[string]string{
+		"Handler":      "Handler handles.",
+		"Controller.M": "M handles by controller.",
+	})
+
+	assert.Equal(t, "Handler handles.", handlerDoc("gopkg.in/foo/bar%2ev2.Handler"))
+	assert.Equal(t, "M handles by controller.", handlerDoc("gopkg.in/foo/bar%2ev2.(*Controller).M-fm"))
+	assert.Equal(t, "M handles by controller.", handlerDoc("gopkg.in/foo/bar%2ev2.Controller.M"))
+	assert.Equal(t, "", handlerDoc("gopkg.in/foo/bar%2ev2.Handler.func1"))
+	assert.Equal(t, "", handlerDoc("main"))
+}
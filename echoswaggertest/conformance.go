@@ -0,0 +1,206 @@
+// Package echoswaggertest provides helpers to test echoswagger docs
+// against the handlers they describe.
+package echoswaggertest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/pangpanglabs/echoswagger"
+)
+
+// Config configures Run.
+type Config struct {
+	// Values of parameters by name, which are used instead of generated
+	// values, e.g. ID of an existing record for path parameter "id".
+	Values map[string]string
+	// Prepare is called with each request before it's sent,
+	// e.g. to set credentials.
+	Prepare func(req *http.Request)
+	// Skip skips operations it returns true for, path is the path in spec.
+	Skip func(method, path string) bool
+	// SkipRequired disables checking that missing required parameters
+	// are rejected.
+	SkipRequired bool
+}
+
+// Run sends a request generated from parameters of each operation in spec
+// of root to its echo, and checks that the response code is declared, the
+// response body matches the schema, and requests missing a required
+// parameter get a 4xx response. It must be called before spec is generated,
+// since echo of root is released then.
+func Run(t *testing.T, root echoswagger.ApiRoot) {
+	RunWithConfig(t, root, Config{})
+}
+
+// RunWithConfig is like Run, with config.
+func RunWithConfig(t *testing.T, root echoswagger.ApiRoot, config Config) {
+	r, ok := root.(*echoswagger.Root)
+	if !ok {
+		t.Skip("echoswaggertest: docs are disabled")
+	}
+	cs, err := newConformances(r, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range cs {
+		t.Run(c.method+" "+c.path, c.run)
+	}
+}
+
+// newConformances creates conformance of operations in spec of r,
+// sorted by path.
+func newConformances(r *echoswagger.Root, config Config) ([]*conformance, error) {
+	e := r.Echo()
+	if e == nil {
+		return nil, errors.New("echoswaggertest: echo of root is released, run it before spec is generated")
+	}
+	spec, err := r.GetSpec(nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(spec.Paths))
+	for k := range spec.Paths {
+		paths = append(paths, k)
+	}
+	sort.Strings(paths)
+	var cs []*conformance
+	for _, path := range paths {
+		p, ok := spec.Paths[path].(*echoswagger.Path)
+		if !ok {
+			continue
+		}
+		for _, op := range operations(p) {
+			if config.Skip != nil && config.Skip(op.method, path) {
+				continue
+			}
+			cs = append(cs, &conformance{
+				e:         e,
+				spec:      &spec,
+				config:    config,
+				method:    op.method,
+				path:      path,
+				operation: op.operation,
+			})
+		}
+	}
+	return cs, nil
+}
+
+type operation struct {
+	method    string
+	operation *echoswagger.Operation
+}
+
+func operations(p *echoswagger.Path) []operation {
+	var ops []operation
+	for _, op := range []operation{
+		{echo.GET, p.Get}, {echo.PUT, p.Put}, {echo.POST, p.Post}, {echo.DELETE, p.Delete},
+		{echo.OPTIONS, p.Options}, {echo.HEAD, p.Head}, {echo.PATCH, p.Patch},
+	} {
+		if op.operation != nil {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// conformance tests an operation.
+type conformance struct {
+	e         *echo.Echo
+	spec      *echoswagger.Swagger
+	config    Config
+	method    string
+	path      string
+	operation *echoswagger.Operation
+}
+
+func (c *conformance) run(t *testing.T) {
+	msgs, err := c.check()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range msgs {
+		t.Error(msg)
+	}
+}
+
+// check returns messages of mismatches between the operation and responses.
+func (c *conformance) check() ([]string, error) {
+	rec, err := c.send(nil)
+	if err != nil {
+		return nil, err
+	}
+	msgs := c.checkResponse(rec)
+
+	if c.config.SkipRequired {
+		return msgs, nil
+	}
+	for _, p := range c.operation.Parameters {
+		if !p.Required || p.In == "path" {
+			continue
+		}
+		rec, err := c.send(p)
+		if err != nil {
+			return nil, err
+		}
+		if rec.Code < 400 || rec.Code >= 500 {
+			msgs = append(msgs, fmt.Sprintf("echoswaggertest: request without required %s parameter %s got status %d, want 4xx", p.In, p.Name, rec.Code))
+		}
+	}
+	return msgs, nil
+}
+
+// send sends request of the operation without parameter omit.
+func (c *conformance) send(omit *echoswagger.Parameter) (*httptest.ResponseRecorder, error) {
+	req, err := c.newRequest(omit)
+	if err != nil {
+		return nil, err
+	}
+	if c.config.Prepare != nil {
+		c.config.Prepare(req)
+	}
+	rec := httptest.NewRecorder()
+	c.e.ServeHTTP(rec, req)
+	return rec, nil
+}
+
+// checkResponse checks rec is a declared response of the operation.
+func (c *conformance) checkResponse(rec *httptest.ResponseRecorder) []string {
+	res, ok := c.operation.Responses[strconv.Itoa(rec.Code)]
+	if !ok {
+		res, ok = c.operation.Responses["default"]
+	}
+	if !ok {
+		codes := make([]string, 0, len(c.operation.Responses))
+		for k := range c.operation.Responses {
+			codes = append(codes, k)
+		}
+		sort.Strings(codes)
+		return []string{fmt.Sprintf("echoswaggertest: status %d isn't declared, declared: %s, body: %s",
+			rec.Code, strings.Join(codes, ", "), strings.TrimSpace(rec.Body.String()))}
+	}
+	if res.Schema == nil || res.Schema.Type == "file" || c.method == echo.HEAD {
+		return nil
+	}
+	if ct := rec.Header().Get(echo.HeaderContentType); !strings.HasPrefix(ct, echo.MIMEApplicationJSON) {
+		return []string{fmt.Sprintf("echoswaggertest: content type of status %d is %q, want JSON", rec.Code, ct)}
+	}
+	v, err := decodeJSON(rec.Body.Bytes())
+	if err != nil {
+		return []string{fmt.Sprintf("echoswaggertest: invalid JSON body of status %d: %v", rec.Code, err)}
+	}
+	var msgs []string
+	for _, msg := range newValidator(c.spec).validate(v, res.Schema, "body") {
+		msgs = append(msgs, fmt.Sprintf("echoswaggertest: body of status %d doesn't match schema: %s", rec.Code, msg))
+	}
+	return msgs
+}
@@ -0,0 +1,170 @@
+package echoswaggertest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/pangpanglabs/echoswagger"
+	"github.com/stretchr/testify/assert"
+)
+
+type pet struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name" swagger:"required"`
+	Status string `json:"status" swagger:"enum(available|sold)"`
+}
+
+func prepareRoot() echoswagger.ApiRoot {
+	r := echoswagger.New(echo.New(), "doc/", nil)
+	g := r.Group("Pets", "/pets")
+	g.GET("/:id", func(c echo.Context) error {
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			return echo.ErrBadRequest
+		}
+		return c.JSON(http.StatusOK, pet{ID: id, Name: "doggie", Status: "sold"})
+	}).
+		AddParamPath(0, "id", "ID of pet").
+		AddResponse(http.StatusOK, "pet", &pet{}, nil).
+		AddResponse(http.StatusBadRequest, "invalid id", nil, nil)
+
+	g.POST("", func(c echo.Context) error {
+		var p pet
+		if err := c.Bind(&p); err != nil || p.Name == "" {
+			return echo.ErrBadRequest
+		}
+		return c.JSON(http.StatusCreated, p)
+	}).
+		AddParamBody(&pet{}, "body", "pet", true).
+		AddResponse(http.StatusCreated, "created", &pet{}, nil).
+		AddResponse(http.StatusBadRequest, "invalid pet", nil, nil)
+
+	g.GET("", func(c echo.Context) error {
+		if c.QueryParam("status") == "" || c.Request().Header.Get("X-Token") == "" {
+			return echo.ErrBadRequest
+		}
+		return c.JSON(http.StatusOK, []pet{{ID: 1, Name: "doggie", Status: "available"}})
+	}).
+		AddParamQuery([]string{}, "status", "status", true).
+		AddParamHeader("", "X-Token", "token", true).
+		AddResponse(http.StatusOK, "pets", []pet{}, nil).
+		AddResponse(http.StatusBadRequest, "invalid status", nil, nil)
+
+	g.POST("/:id/photo", func(c echo.Context) error {
+		if _, err := c.FormFile("photo"); err != nil {
+			return echo.ErrBadRequest
+		}
+		return c.NoContent(http.StatusNoContent)
+	}).
+		AddParamPath(0, "id", "ID of pet").
+		AddParamFile("photo", "photo", true).
+		AddResponse(http.StatusNoContent, "uploaded", nil, nil).
+		AddResponse(http.StatusBadRequest, "no photo", nil, nil)
+
+	g.POST("/:id/photos", func(c echo.Context) error {
+		form, err := c.MultipartForm()
+		if err != nil || len(form.File["photos"]) == 0 ||
+			form.File["photos"][0].Header.Get(echo.HeaderContentType) != "image/png" {
+			return echo.ErrBadRequest
+		}
+		var p pet
+		if err := json.Unmarshal([]byte(c.FormValue("metadata")), &p); err != nil || p.Name == "" {
+			return echo.ErrBadRequest
+		}
+		return c.NoContent(http.StatusNoContent)
+	}).
+		AddParamPath(0, "id", "ID of pet").
+		AddParamFiles("photos", "photos", true).
+		SetParamContentType("photos", "image/png").
+		AddParamFormJSON(&pet{}, "metadata", "pet of photos", true).
+		AddResponse(http.StatusNoContent, "uploaded", nil, nil).
+		AddResponse(http.StatusBadRequest, "no photos", nil, nil)
+	return r
+}
+
+func TestRun(t *testing.T) {
+	Run(t, prepareRoot())
+
+	t.Run("Nop", func(t *testing.T) {
+		Run(t, echoswagger.NewNop(echo.New()))
+		t.Error("Run with NopRoot should skip")
+	})
+}
+
+func TestCheck(t *testing.T) {
+	r := echoswagger.New(echo.New(), "doc/", nil)
+	r.GET("/status", func(c echo.Context) error {
+		return c.String(http.StatusInternalServerError, "oops")
+	}).AddResponse(http.StatusOK, "ok", nil, nil)
+	r.GET("/body", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]interface{}{"id": "1", "status": "lost"})
+	}).AddResponse(http.StatusOK, "pet", &pet{}, nil)
+	r.GET("/text", func(c echo.Context) error {
+		return c.String(http.StatusOK, "pet")
+	}).AddResponse(http.StatusOK, "pet", &pet{}, nil)
+	r.GET("/required", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}).AddParamQuery(0, "limit", "limit", true)
+	r.GET("/skipped", func(c echo.Context) error {
+		return c.NoContent(http.StatusInternalServerError)
+	})
+
+	cs, err := newConformances(r.(*echoswagger.Root), Config{
+		Skip: func(method, path string) bool { return path == "/skipped" },
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, cs, 4) {
+		expected := map[string][]string{
+			"/body": {
+				"echoswaggertest: body of status 200 doesn't match schema: body: required property name is missing",
+				`echoswaggertest: body of status 200 doesn't match schema: body.id: "1" isn't integer`,
+				"echoswaggertest: body of status 200 doesn't match schema: body.status: lost isn't in enum [available sold]",
+			},
+			"/required": {
+				"echoswaggertest: request without required query parameter limit got status 200, want 4xx",
+			},
+			"/status": {
+				"echoswaggertest: status 500 isn't declared, declared: 200, body: oops",
+			},
+			"/text": {
+				`echoswaggertest: content type of status 200 is "text/plain; charset=UTF-8", want JSON`,
+			},
+		}
+		for _, c := range cs {
+			msgs, err := c.check()
+			assert.NoError(t, err)
+			assert.Equal(t, expected[c.path], msgs, c.path)
+		}
+	}
+
+	_, err = newConformances(r.(*echoswagger.Root), Config{})
+	assert.EqualError(t, err, "echoswaggertest: echo of root is released, run it before spec is generated")
+}
+
+func TestConfig(t *testing.T) {
+	var ids []string
+	r := echoswagger.New(echo.New(), "doc/", nil)
+	r.GET("/pets/:id", func(c echo.Context) error {
+		ids = append(ids, c.Param("id"))
+		if c.Request().Header.Get(echo.HeaderAuthorization) == "" {
+			return echo.ErrUnauthorized
+		}
+		return c.NoContent(http.StatusOK)
+	}).
+		AddParamPath(0, "id", "ID of pet").
+		AddParamQuery(0, "limit", "limit", true).
+		AddResponse(http.StatusOK, "ok", nil, nil)
+
+	RunWithConfig(t, r, Config{
+		Values: map[string]string{"id": "42"},
+		Prepare: func(req *http.Request) {
+			if req.URL.Query().Get("limit") != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+			}
+		},
+	})
+	assert.Equal(t, []string{"42", "42"}, ids)
+}
@@ -0,0 +1,202 @@
+package echoswaggertest
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pangpanglabs/echoswagger"
+)
+
+// UpdateEnv is the environment variable making AssertGolden rewrite golden
+// files, e.g. `ECHOSWAGGER_UPDATE=1 go test`.
+const UpdateEnv = "ECHOSWAGGER_UPDATE"
+
+// updating reports whether golden files should be rewritten, by UpdateEnv
+// or boolean flag "update" if it's defined by the test package.
+func updating() bool {
+	if ok, err := strconv.ParseBool(os.Getenv(UpdateEnv)); err == nil && ok {
+		return true
+	}
+	if f := flag.Lookup("update"); f != nil {
+		if g, ok := f.Value.(flag.Getter); ok {
+			ok, _ := g.Get().(bool)
+			return ok
+		}
+	}
+	return false
+}
+
+// maxDiffs limits differences printed by AssertGolden.
+const maxDiffs = 50
+
+// AssertGolden asserts that spec of root equals the golden file, and prints
+// differences of paths, operations, definitions and other fields if not.
+// Golden file is rewritten if UpdateEnv is set to true, or test runs with
+// flag `-update` defined by the test package. Host and
+// basePath of spec are not resolved, as no request is involved.
+func AssertGolden(t testing.TB, root echoswagger.ApiRoot, file string) {
+	t.Helper()
+	r, ok := root.(*echoswagger.Root)
+	if !ok {
+		t.Skip("echoswaggertest: docs are disabled")
+	}
+	spec, err := r.GetSpec(nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := updating()
+	diffs, err := checkGolden(spec, file, update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update {
+		t.Logf("echoswaggertest: %s is updated", file)
+		return
+	}
+	if len(diffs) > 0 {
+		if len(diffs) > maxDiffs {
+			diffs = append(diffs[:maxDiffs], fmt.Sprintf("... and %d more", len(diffs)-maxDiffs))
+		}
+		t.Errorf("echoswaggertest: spec differs from %s, run test with %s=1 to rewrite it:\n\t%s",
+			file, UpdateEnv, strings.Join(diffs, "\n\t"))
+	}
+}
+
+// checkGolden returns differences between file and spec,
+// or writes spec into file if update is true.
+func checkGolden(spec echoswagger.Swagger, file string, update bool) ([]string, error) {
+	b, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if update {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return nil, err
+		}
+		return nil, ioutil.WriteFile(file, append(b, '\n'), 0644)
+	}
+
+	golden, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("echoswaggertest: %s doesn't exist, run test with %s=1 to create it", file, UpdateEnv)
+	} else if err != nil {
+		return nil, err
+	}
+	var expected, actual interface{}
+	if err := json.Unmarshal(golden, &expected); err != nil {
+		return nil, fmt.Errorf("echoswaggertest: invalid golden file %s: %v", file, err)
+	}
+	if err := json.Unmarshal(b, &actual); err != nil {
+		return nil, err
+	}
+	var diffs []string
+	diffJSON(expected, actual, "", &diffs)
+	return diffs, nil
+}
+
+// diffJSON appends differences between decoded JSON values expected and
+// actual at path to diffs, objects are compared by sorted keys.
+func diffJSON(expected, actual interface{}, path string, diffs *[]string) {
+	if reflect.DeepEqual(expected, actual) {
+		return
+	}
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(e)+len(a))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		for k := range a {
+			if _, ok := e[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			ev, eok := e[k]
+			av, aok := a[k]
+			p := jsonPath(path, k)
+			switch {
+			case !eok:
+				*diffs = append(*diffs, "+ "+p+describe(p))
+			case !aok:
+				*diffs = append(*diffs, "- "+p+describe(p))
+			default:
+				diffJSON(ev, av, p, diffs)
+			}
+		}
+		return
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(e) || i < len(a); i++ {
+			p := path + "[" + strconv.Itoa(i) + "]"
+			switch {
+			case i >= len(e):
+				*diffs = append(*diffs, "+ "+p+": "+compact(a[i]))
+			case i >= len(a):
+				*diffs = append(*diffs, "- "+p+": "+compact(e[i]))
+			default:
+				diffJSON(e[i], a[i], p, diffs)
+			}
+		}
+		return
+	}
+	*diffs = append(*diffs, "~ "+path+": "+compact(expected)+" => "+compact(actual))
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$-]*$`)
+
+// jsonPath appends key to path, like `paths["/pets"].get.summary`.
+func jsonPath(path, key string) string {
+	if identifier.MatchString(key) {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+// describe names what an added or removed item at path is.
+func describe(path string) string {
+	switch {
+	case strings.HasPrefix(path, "paths[") && strings.Count(path, "]") == 1:
+		if strings.HasSuffix(path, "]") {
+			return " (path)"
+		}
+		if !strings.Contains(path[strings.Index(path, "]")+2:], ".") {
+			return " (operation)"
+		}
+	case strings.HasPrefix(path, "definitions") && strings.Count(path, ".")+strings.Count(path, "[") == 1:
+		return " (definition)"
+	}
+	return ""
+}
+
+func compact(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	if len(b) > 80 {
+		return string(b[:77]) + "..."
+	}
+	return string(b)
+}
@@ -0,0 +1,97 @@
+package echoswaggertest
+
+import (
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/pangpanglabs/echoswagger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssertGolden(t *testing.T) {
+	AssertGolden(t, prepareRoot(), "testdata/swagger.json")
+
+	t.Run("Nop", func(t *testing.T) {
+		AssertGolden(t, echoswagger.NewNop(echo.New()), "testdata/swagger.json")
+		t.Error("AssertGolden with NopRoot should skip")
+	})
+}
+
+// update is defined like other golden file tests, which doesn't conflict.
+var update = flag.Bool("update", false, "rewrite golden files")
+
+func TestUpdating(t *testing.T) {
+	defer os.Unsetenv(UpdateEnv)
+	defer flag.Set("update", strconv.FormatBool(*update))
+	flag.Set("update", "false")
+
+	assert.False(t, updating())
+	os.Setenv(UpdateEnv, "1")
+	assert.True(t, updating())
+	os.Setenv(UpdateEnv, "false")
+	assert.False(t, updating())
+	flag.Set("update", "true")
+	assert.True(t, *update)
+	assert.True(t, updating())
+}
+
+func TestCheckGolden(t *testing.T) {
+	dir, err := ioutil.TempDir("", "echoswaggertest")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "testdata", "swagger.json")
+
+	spec := func(f func(r echoswagger.ApiRoot)) echoswagger.Swagger {
+		r := echoswagger.New(echo.New(), "doc/", &echoswagger.Info{Title: "Pets"})
+		f(r)
+		s, err := r.(*echoswagger.Root).GetSpec(nil, "")
+		assert.NoError(t, err)
+		return s
+	}
+	old := spec(func(r echoswagger.ApiRoot) {
+		r.GET("/pets", testHandler).SetSummary("List pets")
+		r.GET("/pets/:id", testHandler).AddResponse(http.StatusOK, "pet", &pet{}, nil)
+		r.DELETE("/pets/:id", testHandler)
+	})
+
+	_, err = checkGolden(old, file, false)
+	assert.EqualError(t, err, "echoswaggertest: "+file+" doesn't exist, run test with ECHOSWAGGER_UPDATE=1 to create it")
+
+	diffs, err := checkGolden(old, file, true)
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+	diffs, err = checkGolden(old, file, false)
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	type owner struct {
+		Name string `json:"name"`
+	}
+	diffs, err = checkGolden(spec(func(r echoswagger.ApiRoot) {
+		r.GET("/pets", testHandler).SetSummary("List all pets")
+		r.GET("/pets/:id", testHandler).AddResponse(http.StatusOK, "owner", &owner{}, nil)
+		r.POST("/owners", testHandler)
+	}), file, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"+ definitions.owner (definition)",
+		"- definitions.pet (definition)",
+		`+ paths["/owners"] (path)`,
+		`~ paths["/pets"].get.summary: "List pets" => "List all pets"`,
+		`- paths["/pets/{id}"].delete (operation)`,
+		`~ paths["/pets/{id}"].get.responses["200"].description: "pet" => "owner"`,
+		`~ paths["/pets/{id}"].get.responses["200"].schema.$ref: "#/definitions/pet" => "#/definitions/owner"`,
+	}, diffs)
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("{"), 0644))
+	_, err = checkGolden(old, file, false)
+	assert.EqualError(t, err, "echoswaggertest: invalid golden file "+file+": unexpected end of JSON input")
+}
+
+func testHandler(c echo.Context) error { return nil }
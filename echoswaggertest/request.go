@@ -0,0 +1,334 @@
+package echoswaggertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo"
+	"github.com/pangpanglabs/echoswagger"
+)
+
+// maxSchemaDepth limits depth of generated bodies, as definitions can
+// reference themselves.
+const maxSchemaDepth = 8
+
+// newRequest generates request of the operation without parameter omit,
+// it contains required parameters, body parameters, and parameters
+// which have values in Config.
+func (c *conformance) newRequest(omit *echoswagger.Parameter) (*http.Request, error) {
+	path := c.path
+	query := make(url.Values)
+	header := make(http.Header)
+	form := make(url.Values)
+	var parts []*echoswagger.Parameter
+	var body interface{}
+	hasBody := false
+
+	for _, p := range c.operation.Parameters {
+		if p == omit {
+			continue
+		}
+		_, ok := c.config.Values[p.Name]
+		if !ok && !p.Required && p.In != "body" && p.In != "path" {
+			continue
+		}
+		switch p.In {
+		case "path":
+			path = strings.Replace(path, "{"+p.Name+"}", url.PathEscape(c.paramValue(p)), 1)
+		case "query":
+			addValue(query, p, c.paramValue(p))
+		case "header":
+			header.Set(p.Name, c.paramValue(p))
+		case "formData":
+			if isPart(p) {
+				parts = append(parts, p)
+			} else {
+				addValue(form, p, c.paramValue(p))
+			}
+		case "body":
+			body = newValueGenerator(c.spec).value(p.Schema, 0)
+			hasBody = true
+		}
+	}
+
+	var reader io.Reader
+	contentType := ""
+	switch {
+	case len(parts) > 0:
+		buf := new(bytes.Buffer)
+		w := multipart.NewWriter(buf)
+		for k, vs := range form {
+			for _, v := range vs {
+				if err := w.WriteField(k, v); err != nil {
+					return nil, err
+				}
+			}
+		}
+		for _, p := range parts {
+			if err := c.writePart(w, p); err != nil {
+				return nil, err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		reader, contentType = buf, w.FormDataContentType()
+	case len(form) > 0:
+		reader, contentType = strings.NewReader(form.Encode()), echo.MIMEApplicationForm
+	case hasBody:
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader, contentType = bytes.NewReader(b), echo.MIMEApplicationJSON
+	}
+
+	target := path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req := httptest.NewRequest(c.method, target, reader)
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+	if contentType != "" {
+		req.Header.Set(echo.HeaderContentType, contentType)
+	}
+	req.Header.Set(echo.HeaderAccept, echo.MIMEApplicationJSON)
+	return req, nil
+}
+
+// isPart reports whether formData parameter p is a file, or a part with
+// content types, which is sent in multipart request.
+func isPart(p *echoswagger.Parameter) bool {
+	_, ok := p.Extensions["x-content-type"]
+	return ok || isFile(p)
+}
+
+func isFile(p *echoswagger.Parameter) bool {
+	return p.Type == "file" || p.Type == "array" && p.Items != nil && p.Items.Type == "file"
+}
+
+// writePart writes part of parameter p with the first of its content types,
+// a file of p is plain text, a part in JSON is generated from its "x-schema".
+func (c *conformance) writePart(w *multipart.Writer, p *echoswagger.Parameter) error {
+	contentType := "application/octet-stream"
+	if v, ok := p.Extensions["x-content-type"].(string); ok {
+		contentType = strings.TrimSpace(strings.Split(v, ",")[0])
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set(echo.HeaderContentType, contentType)
+	var content []byte
+	if isFile(p) {
+		h.Set(echo.HeaderContentDisposition, fmt.Sprintf(`form-data; name=%q; filename=%q`, p.Name, p.Name+".txt"))
+		content = []byte("echoswaggertest")
+	} else {
+		h.Set(echo.HeaderContentDisposition, fmt.Sprintf(`form-data; name=%q`, p.Name))
+		if v, ok := c.config.Values[p.Name]; ok {
+			content = []byte(v)
+		} else if s := partSchema(p); s != nil {
+			b, err := json.Marshal(newValueGenerator(c.spec).value(s, 0))
+			if err != nil {
+				return err
+			}
+			content = b
+		} else {
+			content = []byte(c.paramValue(p))
+		}
+	}
+	f, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+// partSchema returns "x-schema" of parameter p, or nil if it has none.
+func partSchema(p *echoswagger.Parameter) *echoswagger.JSONSchema {
+	switch v := p.Extensions["x-schema"].(type) {
+	case nil:
+		return nil
+	case *echoswagger.JSONSchema:
+		return v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil
+		}
+		s := &echoswagger.JSONSchema{}
+		if err := json.Unmarshal(b, s); err != nil {
+			return nil
+		}
+		return s
+	}
+}
+
+// addValue adds value of array or simple parameter p to values.
+func addValue(values url.Values, p *echoswagger.Parameter, v string) {
+	if p.Type == "array" && p.CollectionFormat == "multi" {
+		for _, s := range strings.Split(v, ",") {
+			values.Add(p.Name, s)
+		}
+		return
+	}
+	values.Set(p.Name, v)
+}
+
+// paramValue returns value of parameter p, which is set in Config,
+// or from its example, default, enum or type.
+func (c *conformance) paramValue(p *echoswagger.Parameter) string {
+	if v, ok := c.config.Values[p.Name]; ok {
+		return v
+	}
+	if p.Type == "array" && p.Items != nil {
+		if v, ok := p.Extensions["x-example"]; ok {
+			return formatValue(v)
+		}
+		return itemsValue(p.Items)
+	}
+	if v, ok := p.Extensions["x-example"]; ok {
+		return formatValue(v)
+	}
+	if p.Default != nil {
+		return formatValue(p.Default)
+	}
+	if len(p.Enum) > 0 {
+		return formatValue(p.Enum[0])
+	}
+	return formatValue(simpleValue(p.Type, p.Format, p.Minimum, p.MinLength))
+}
+
+func itemsValue(items *echoswagger.Items) string {
+	switch {
+	case items.Default != nil:
+		return formatValue(items.Default)
+	case len(items.Enum) > 0:
+		return formatValue(items.Enum[0])
+	case items.Type == "array" && items.Items != nil:
+		return itemsValue(items.Items)
+	}
+	return formatValue(simpleValue(items.Type, items.Format, items.Minimum, items.MinLength))
+}
+
+// formatValue formats v as parameter value, arrays are separated by comma.
+func formatValue(v interface{}) string {
+	if b, err := json.Marshal(v); err == nil && len(b) > 0 && b[0] == '[' {
+		var vs []interface{}
+		if err := json.Unmarshal(b, &vs); err == nil {
+			s := make([]string, len(vs))
+			for i := range vs {
+				s[i] = formatValue(vs[i])
+			}
+			return strings.Join(s, ",")
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+// simpleValue generates a valid value of simple type.
+func simpleValue(typ, format string, minimum *float64, minLength *int) interface{} {
+	switch typ {
+	case "integer":
+		if minimum != nil {
+			return int64(math.Floor(*minimum)) + 1
+		}
+		return 1
+	case "number":
+		if minimum != nil {
+			return *minimum + 1
+		}
+		return 1.5
+	case "boolean":
+		return true
+	case "string":
+		s := "string"
+		switch format {
+		case "date-time":
+			s = "2006-01-02T15:04:05Z"
+		case "date":
+			s = "2006-01-02"
+		case "uuid":
+			s = "00000000-0000-0000-0000-000000000000"
+		case "email":
+			s = "user@example.com"
+		case "uri", "url":
+			s = "http://example.com"
+		case "ipv4":
+			s = "127.0.0.1"
+		case "ipv6":
+			s = "::1"
+		case "hostname":
+			s = "example.com"
+		}
+		if minLength != nil && len(s) < *minLength {
+			s += strings.Repeat("s", *minLength-len(s))
+		}
+		return s
+	}
+	return nil
+}
+
+// valueGenerator generates values of schemas.
+type valueGenerator struct {
+	definitions map[string]*echoswagger.JSONSchema
+}
+
+func newValueGenerator(spec *echoswagger.Swagger) *valueGenerator {
+	return &valueGenerator{definitions: spec.Definitions}
+}
+
+func (g *valueGenerator) value(s *echoswagger.JSONSchema, depth int) interface{} {
+	if s == nil || depth > maxSchemaDepth {
+		return nil
+	}
+	if s.Ref != "" {
+		return g.value(resolveRef(g.definitions, s.Ref), depth+1)
+	}
+	switch {
+	case s.Example != nil:
+		return s.Example
+	case s.DefaultValue != nil:
+		return s.DefaultValue
+	case len(s.Enum) > 0:
+		return s.Enum[0]
+	}
+	switch s.Type {
+	case "object", "":
+		m := make(map[string]interface{})
+		for name, prop := range s.Properties {
+			if prop.ReadOnly {
+				continue
+			}
+			if v := g.value(prop, depth+1); v != nil {
+				m[name] = v
+			}
+		}
+		return m
+	case "array":
+		n := 1
+		if s.MinItems != nil && *s.MinItems > n {
+			n = *s.MinItems
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			items[i] = g.value(s.Items, depth+1)
+		}
+		return items
+	}
+	return simpleValue(string(s.Type), s.Format, s.Minimum, s.MinLength)
+}
+
+// resolveRef returns definition referenced by ref.
+func resolveRef(definitions map[string]*echoswagger.JSONSchema, ref string) *echoswagger.JSONSchema {
+	return definitions[strings.TrimPrefix(ref, "#/definitions/")]
+}
@@ -0,0 +1,140 @@
+package echoswaggertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/pangpanglabs/echoswagger"
+)
+
+// decodeJSON decodes b, keeping numbers as json.Number.
+func decodeJSON(b []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	err := d.Decode(&v)
+	return v, err
+}
+
+// validator validates JSON values against schemas.
+type validator struct {
+	definitions map[string]*echoswagger.JSONSchema
+}
+
+func newValidator(spec *echoswagger.Swagger) *validator {
+	return &validator{definitions: spec.Definitions}
+}
+
+// validate returns mismatches between v and schema s, path is the location
+// of v in messages. Null matches any schema, as Go encodes nil pointers,
+// slices and maps as null, but required properties can't be null.
+func (vd *validator) validate(v interface{}, s *echoswagger.JSONSchema, path string) []string {
+	if s == nil || v == nil {
+		return nil
+	}
+	if s.Ref != "" {
+		def := resolveRef(vd.definitions, s.Ref)
+		if def == nil {
+			return []string{fmt.Sprintf("%s: definition %s not found", path, s.Ref)}
+		}
+		return vd.validate(v, def, path)
+	}
+	if len(s.AnyOf) > 0 {
+		for _, sub := range s.AnyOf {
+			if len(vd.validate(v, sub, path)) == 0 {
+				return nil
+			}
+		}
+		return []string{fmt.Sprintf("%s: doesn't match any schema of anyOf", path)}
+	}
+	if len(s.Enum) > 0 && !inEnum(v, s.Enum) {
+		return []string{fmt.Sprintf("%s: %v isn't in enum %v", path, v, s.Enum)}
+	}
+
+	switch s.Type {
+	case "object":
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return mismatch(path, s.Type, v)
+		}
+		return vd.validateObject(m, s, path)
+	case "array":
+		a, ok := v.([]interface{})
+		if !ok {
+			return mismatch(path, s.Type, v)
+		}
+		var msgs []string
+		for i, item := range a {
+			msgs = append(msgs, vd.validate(item, s.Items, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return msgs
+	case "string":
+		if _, ok := v.(string); !ok {
+			return mismatch(path, s.Type, v)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return mismatch(path, s.Type, v)
+		}
+	case "integer", "number":
+		n, ok := v.(json.Number)
+		if !ok {
+			return mismatch(path, s.Type, v)
+		}
+		f, err := n.Float64()
+		if err != nil || s.Type == "integer" && f != math.Trunc(f) {
+			return mismatch(path, s.Type, v)
+		}
+	case "":
+		if m, ok := v.(map[string]interface{}); ok && len(s.Properties) > 0 {
+			return vd.validateObject(m, s, path)
+		}
+	}
+	return nil
+}
+
+func (vd *validator) validateObject(m map[string]interface{}, s *echoswagger.JSONSchema, path string) []string {
+	var msgs []string
+	for _, name := range s.Required {
+		if m[name] == nil {
+			msgs = append(msgs, fmt.Sprintf("%s: required property %s is missing", path, name))
+		}
+	}
+	names := make([]string, 0, len(m))
+	for k := range m {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if prop, ok := s.Properties[name]; ok {
+			msgs = append(msgs, vd.validate(m[name], prop, path+"."+name)...)
+		} else if s.AdditionalProperties != nil {
+			msgs = append(msgs, vd.validate(m[name], s.AdditionalProperties, path+"."+name)...)
+		}
+	}
+	return msgs
+}
+
+func mismatch(path string, typ echoswagger.JSONType, v interface{}) []string {
+	b, _ := json.Marshal(v)
+	return []string{fmt.Sprintf("%s: %s isn't %s", path, b, typ)}
+}
+
+// inEnum reports whether JSON value v equals one of enum.
+func inEnum(v interface{}, enum []interface{}) bool {
+	for _, e := range enum {
+		b, err := json.Marshal(e)
+		if err != nil {
+			continue
+		}
+		ev, err := decodeJSON(b)
+		if err == nil && reflect.DeepEqual(v, ev) {
+			return true
+		}
+	}
+	return false
+}
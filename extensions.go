@@ -0,0 +1,185 @@
+package echoswagger
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// marshalWithExtensions marshals v, which must encode to a JSON object,
+// and appends the swagger extensions in ext to it.
+// Only keys beginning with "x-" are written.
+func marshalWithExtensions(v interface{}, ext map[string]interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(ext) == 0 {
+		return b, err
+	}
+
+	keys := make([]string, 0, len(ext))
+	for k := range ext {
+		if strings.HasPrefix(k, "x-") {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return b, nil
+	}
+	sort.Strings(keys)
+
+	buf := bytes.NewBuffer(b[:len(b)-1])
+	for i, k := range keys {
+		if i > 0 || len(b) > 2 {
+			buf.WriteByte(',')
+		}
+		kb, _ := json.Marshal(k)
+		vb, err := json.Marshal(ext[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+		buf.Write(vb)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// unmarshalWithExtensions unmarshals b into v, and collects keys of b
+// beginning with "x-" into ext.
+func unmarshalWithExtensions(b []byte, v interface{}, ext *map[string]interface{}) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+	for k, raw := range fields {
+		if !strings.HasPrefix(k, "x-") {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		if *ext == nil {
+			*ext = make(map[string]interface{})
+		}
+		(*ext)[k] = value
+	}
+	return nil
+}
+
+// UnmarshalJSON unmarshals paths into `*Path`, which are the values
+// of Paths generated by echoswagger.
+func (s *Swagger) UnmarshalJSON(b []byte) error {
+	type alias Swagger
+	aux := struct {
+		*alias
+		Paths map[string]*Path `json:"paths"`
+	}{alias: (*alias)(s)}
+	if err := unmarshalWithExtensions(b, &aux, &s.Extensions); err != nil {
+		return err
+	}
+	s.Paths = make(map[string]interface{}, len(aux.Paths))
+	for k, v := range aux.Paths {
+		s.Paths[k] = v
+	}
+	return nil
+}
+
+func (s Swagger) MarshalJSON() ([]byte, error) {
+	type alias Swagger
+	return marshalWithExtensions(alias(s), s.Extensions)
+}
+
+func (i Info) MarshalJSON() ([]byte, error) {
+	type alias Info
+	return marshalWithExtensions(alias(i), i.Extensions)
+}
+
+func (p Path) MarshalJSON() ([]byte, error) {
+	type alias Path
+	return marshalWithExtensions(alias(p), p.Extensions)
+}
+
+func (o Operation) MarshalJSON() ([]byte, error) {
+	type alias Operation
+	return marshalWithExtensions(alias(o), o.Extensions)
+}
+
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	type alias Parameter
+	return marshalWithExtensions(alias(p), p.Extensions)
+}
+
+func (r Response) MarshalJSON() ([]byte, error) {
+	type alias Response
+	return marshalWithExtensions(alias(r), r.Extensions)
+}
+
+func (h Header) MarshalJSON() ([]byte, error) {
+	type alias Header
+	return marshalWithExtensions(alias(h), h.Extensions)
+}
+
+func (s SecurityDefinition) MarshalJSON() ([]byte, error) {
+	type alias SecurityDefinition
+	return marshalWithExtensions(alias(s), s.Extensions)
+}
+
+func (t Tag) MarshalJSON() ([]byte, error) {
+	type alias Tag
+	return marshalWithExtensions(alias(t), t.Extensions)
+}
+
+func (s JSONSchema) MarshalJSON() ([]byte, error) {
+	type alias JSONSchema
+	return marshalWithExtensions(alias(s), s.Extensions)
+}
+
+func (i *Info) UnmarshalJSON(b []byte) error {
+	type alias Info
+	return unmarshalWithExtensions(b, (*alias)(i), &i.Extensions)
+}
+
+func (p *Path) UnmarshalJSON(b []byte) error {
+	type alias Path
+	return unmarshalWithExtensions(b, (*alias)(p), &p.Extensions)
+}
+
+func (o *Operation) UnmarshalJSON(b []byte) error {
+	type alias Operation
+	return unmarshalWithExtensions(b, (*alias)(o), &o.Extensions)
+}
+
+func (p *Parameter) UnmarshalJSON(b []byte) error {
+	type alias Parameter
+	return unmarshalWithExtensions(b, (*alias)(p), &p.Extensions)
+}
+
+func (r *Response) UnmarshalJSON(b []byte) error {
+	type alias Response
+	return unmarshalWithExtensions(b, (*alias)(r), &r.Extensions)
+}
+
+func (h *Header) UnmarshalJSON(b []byte) error {
+	type alias Header
+	return unmarshalWithExtensions(b, (*alias)(h), &h.Extensions)
+}
+
+func (s *SecurityDefinition) UnmarshalJSON(b []byte) error {
+	type alias SecurityDefinition
+	return unmarshalWithExtensions(b, (*alias)(s), &s.Extensions)
+}
+
+func (t *Tag) UnmarshalJSON(b []byte) error {
+	type alias Tag
+	return unmarshalWithExtensions(b, (*alias)(t), &t.Extensions)
+}
+
+func (s *JSONSchema) UnmarshalJSON(b []byte) error {
+	type alias JSONSchema
+	return unmarshalWithExtensions(b, (*alias)(s), &s.Extensions)
+}
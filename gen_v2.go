@@ -0,0 +1,90 @@
+//go:build ignore
+// +build ignore
+
+// This program generates module github.com/pangpanglabs/echoswagger/v2 in v2/,
+// which is the same package built on echo v4. It's invoked by go generate,
+// don't edit files in v2/ except go.mod and go.sum.
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	outputDir = "v2"
+	directive = "//go:generate go run gen_v2.go\n\n"
+)
+
+var replacer = strings.NewReplacer(
+	`"github.com/labstack/echo"`, `"github.com/labstack/echo/v4"`,
+	`"github.com/pangpanglabs/echoswagger"`, `"github.com/pangpanglabs/echoswagger/v2"`,
+	`"github.com/pangpanglabs/echoswagger.`, `"github.com/pangpanglabs/echoswagger/v2.`,
+)
+
+// packages are directories of packages generated in v2/.
+var packages = []string{".", "echoswaggertest"}
+
+func main() {
+	for _, dir := range packages {
+		generate(dir)
+	}
+}
+
+func generate(dir string) {
+	out := filepath.Join(outputDir, dir)
+	if err := os.MkdirAll(out, 0755); err != nil {
+		log.Fatal(err)
+	}
+	olds, err := filepath.Glob(filepath.Join(out, "*.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range olds {
+		if err := os.Remove(f); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		if f == "gen_v2.go" {
+			continue
+		}
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			log.Fatal(err)
+		}
+		buf := new(bytes.Buffer)
+		buf.WriteString("// Code generated by gen_v2.go from " + filepath.ToSlash(f) + ". DO NOT EDIT.\n\n")
+		buf.WriteString(replacer.Replace(strings.Replace(string(b), directive, "", 1)))
+		if err := ioutil.WriteFile(filepath.Join(outputDir, f), buf.Bytes(), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Files of testdata are copied as they are.
+	files, err = filepath.Glob(filepath.Join(dir, "testdata", "*"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		b, err := ioutil.ReadFile(f)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(outputDir, filepath.Dir(f)), 0755); err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(outputDir, f), b, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"reflect"
 )
 
-func (Items) generate(t reflect.Type) *Items {
-	st, sf := toSwaggerType(t)
+func (Items) generate(t reflect.Type, in ParamInType) *Items {
+	st, sf := toParamType(t, in)
 	item := &Items{
 		Type: st,
 	}
 	if st == "array" {
-		item.Items = Items{}.generate(t.Elem())
+		item.Items = Items{}.generate(t.Elem(), in)
 		item.CollectionFormat = "multi"
 	} else {
 		item.Format = sf
@@ -18,61 +18,72 @@ func (Items) generate(t reflect.Type) *Items {
 	return item
 }
 
-func (Parameter) generate(f reflect.StructField, in ParamInType) *Parameter {
+func (Parameter) generate(owner reflect.Type, f reflect.StructField, in ParamInType, conf *config) (*Parameter, error) {
 	name, _ := getFieldName(f, in)
 	if name == "-" {
-		return nil
+		return nil, nil
 	}
-	st, sf := toSwaggerType(f.Type)
+	st, sf := toParamType(f.Type, in)
 	pm := &Parameter{
 		Name: name,
 		In:   string(in),
 		Type: st,
 	}
 	if st == "array" {
-		pm.Items = Items{}.generate(f.Type.Elem())
+		pm.Items = Items{}.generate(f.Type.Elem(), in)
 		pm.CollectionFormat = "multi"
 	} else {
 		pm.Format = sf
 	}
 
-	pm.handleSwaggerTags(f, name, in)
-	return pm
+	if err := pm.handleSwaggerTags(owner, f, name, in, conf); err != nil {
+		return nil, err
+	}
+	if pm.Description == "" {
+		pm.Description = fieldDoc(owner, f)
+	}
+	return pm, nil
 }
 
-func (Header) generate(f reflect.StructField) *Header {
+func (Header) generate(owner reflect.Type, f reflect.StructField, conf *config) (*Header, error) {
 	name, _ := getFieldName(f, ParamInHeader)
 	if name == "-" {
-		return nil
+		return nil, nil
 	}
 	st, sf := toSwaggerType(f.Type)
 	h := &Header{
 		Type: st,
 	}
 	if st == "array" {
-		h.Items = Items{}.generate(f.Type.Elem())
+		h.Items = Items{}.generate(f.Type.Elem(), ParamInHeader)
 		h.CollectionFormat = "multi"
 	} else {
 		h.Format = sf
 	}
 
-	h.handleSwaggerTags(f, name)
-	return h
+	if err := h.handleSwaggerTags(owner, f, name, conf); err != nil {
+		return nil, err
+	}
+	if h.Description == "" {
+		h.Description = fieldDoc(owner, f)
+	}
+	return h, nil
 }
 
-func (r *RawDefineDic) genSchema(v reflect.Value) *JSONSchema {
+func (r *RawDefineDic) genSchema(v reflect.Value, conf *config) (*JSONSchema, error) {
 	if !v.IsValid() {
-		return nil
+		return nil, nil
 	}
 	v = indirect(v)
 	st, sf := toSwaggerType(v.Type())
 	schema := &JSONSchema{}
+	var err error
 	if st == "array" {
 		schema.Type = JSONType(st)
 		if v.Len() == 0 {
 			v = reflect.MakeSlice(v.Type(), 1, 1)
 		}
-		schema.Items = r.genSchema(v.Index(0))
+		schema.Items, err = r.genSchema(v.Index(0), conf)
 	} else if st == "object" && sf == "map" {
 		schema.Type = JSONType(st)
 		if v.Len() == 0 {
@@ -80,34 +91,40 @@ func (r *RawDefineDic) genSchema(v reflect.Value) *JSONSchema {
 		} else {
 			v = v.MapIndex(v.MapKeys()[0])
 		}
-		schema.AdditionalProperties = r.genSchema(v)
+		schema.AdditionalProperties, err = r.genSchema(v, conf)
 	} else if st == "object" {
-		key := r.addDefinition(v)
+		var key string
+		key, err = r.addDefinition(v, conf)
 		schema.Ref = DefPrefix + key
 	} else {
 		schema.Type = JSONType(st)
 		schema.Format = sf
 		zv := reflect.Zero(v.Type())
-		if v.CanInterface() && zv.CanInterface() && v.Interface() != zv.Interface() {
+		if v.Type().Comparable() && v.CanInterface() && zv.CanInterface() && v.Interface() != zv.Interface() {
 			schema.Example = v.Interface()
 		}
 	}
-	return schema
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
 }
 
-func (api) genHeader(v reflect.Value) map[string]*Header {
+func (a *api) genHeader(v reflect.Value) (map[string]*Header, error) {
 	rt := indirect(v).Type()
 	if rt.Kind() != reflect.Struct {
-		return nil
+		return nil, nil
 	}
 	mh := make(map[string]*Header)
-	for i := 0; i < rt.NumField(); i++ {
-		f := rt.Field(i)
-		h := Header{}.generate(f)
+	for _, pf := range paramFields(rt, ParamInHeader) {
+		h, err := Header{}.generate(pf.owner, pf.field, a.conf)
+		if err != nil {
+			return nil, err
+		}
 		if h != nil {
-			name, _ := getFieldName(f, ParamInHeader)
+			name, _ := getFieldName(pf.field, ParamInHeader)
 			mh[name] = h
 		}
 	}
-	return mh
+	return mh, nil
 }
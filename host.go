@@ -0,0 +1,79 @@
+package echoswagger
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo"
+)
+
+// HostStrategy is the way to resolve host, basePath and schemes of spec.
+type HostStrategy int
+
+const (
+	// HostFromReferer resolves them from `Referer` header of spec requests,
+	// which is the doc page, or from the request itself. It's the default.
+	// The doc page also rewrites host and basePath of the spec embedded in it.
+	HostFromReferer HostStrategy = iota
+	// HostFromForwarded resolves them from `X-Forwarded-Host`,
+	// `X-Forwarded-Proto` and `X-Forwarded-Prefix` headers, falling back to
+	// the request. Use it only behind a proxy which sets these headers.
+	HostFromForwarded
+	// HostFixed uses Host, BasePath and Schemes of HostSetting.
+	HostFixed
+	// HostCustom uses Resolve of HostSetting.
+	HostCustom
+)
+
+// HostSetting sets how to resolve host, basePath and schemes of spec.
+type HostSetting struct {
+	Strategy HostStrategy
+	// Host, BasePath and Schemes are used by HostFixed,
+	// Schemes set by `SetScheme` are kept if it's empty.
+	Host     string
+	BasePath string
+	Schemes  []string
+	// Resolve is used by HostCustom, empty schemes keep those set by `SetScheme`.
+	Resolve func(c echo.Context) (host, basePath string, schemes []string)
+}
+
+// resolveHost sets host, basePath and schemes of spec served at specPath,
+// for request c.
+func (r *Root) resolveHost(c echo.Context, docPath, specPath string, spec *Swagger) {
+	h := r.host
+	var schemes []string
+	switch h.Strategy {
+	case HostFixed:
+		spec.Host, spec.BasePath, schemes = h.Host, h.BasePath, h.Schemes
+	case HostCustom:
+		spec.Host, spec.BasePath, schemes = h.Resolve(c)
+	case HostFromForwarded:
+		req := c.Request()
+		spec.Host = firstHeaderValue(req.Header.Get("X-Forwarded-Host"))
+		if spec.Host == "" {
+			spec.Host = req.Host
+		}
+		spec.BasePath = removeTrailingSlash(firstHeaderValue(req.Header.Get("X-Forwarded-Prefix")))
+		if proto := firstHeaderValue(req.Header.Get(echo.HeaderXForwardedProto)); proto != "" {
+			schemes = []string{proto}
+		}
+	default:
+		if uri, err := url.ParseRequestURI(c.Request().Referer()); err == nil {
+			spec.BasePath = r.trimDocPath(uri.Path, docPath)
+			spec.Host = uri.Host
+		} else {
+			spec.BasePath = trimSuffixSlash(c.Request().URL.Path, specPath)
+			spec.Host = c.Request().Host
+		}
+	}
+	if len(schemes) > 0 {
+		spec.Schemes = schemes
+	}
+}
+
+func firstHeaderValue(v string) string {
+	if i := strings.Index(v, ","); i >= 0 {
+		v = v[:i]
+	}
+	return strings.TrimSpace(v)
+}
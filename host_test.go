@@ -0,0 +1,108 @@
+package echoswagger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostSetting(t *testing.T) {
+	tests := []struct {
+		name     string
+		setting  HostSetting
+		header   map[string]string
+		host     string
+		basePath string
+		schemes  []string
+	}{
+		{
+			name:     "Referer",
+			header:   map[string]string{"Referer": "http://evil.com/api/doc"},
+			host:     "evil.com",
+			basePath: "/api",
+			schemes:  []string{"http"},
+		},
+		{
+			name:    "Forwarded",
+			setting: HostSetting{Strategy: HostFromForwarded},
+			header: map[string]string{
+				"Referer":            "http://evil.com/doc",
+				"X-Forwarded-Host":   "api.example.com, internal:8080",
+				"X-Forwarded-Proto":  "https",
+				"X-Forwarded-Prefix": "/v1/",
+			},
+			host:     "api.example.com",
+			basePath: "/v1",
+			schemes:  []string{"https"},
+		},
+		{
+			name:     "ForwardedFallback",
+			setting:  HostSetting{Strategy: HostFromForwarded},
+			host:     "internal:8080",
+			basePath: "",
+			schemes:  []string{"http"},
+		},
+		{
+			name:     "Fixed",
+			setting:  HostSetting{Strategy: HostFixed, Host: "api.example.com", BasePath: "/v1", Schemes: []string{"https"}},
+			header:   map[string]string{"Referer": "http://evil.com/doc"},
+			host:     "api.example.com",
+			basePath: "/v1",
+			schemes:  []string{"https"},
+		},
+		{
+			name: "Custom",
+			setting: HostSetting{Strategy: HostCustom, Resolve: func(c echo.Context) (string, string, []string) {
+				return "custom.example.com", "/custom", nil
+			}},
+			host:     "custom.example.com",
+			basePath: "/custom",
+			schemes:  []string{"http"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			r := New(e, "doc/", nil).SetScheme("http").SetHost(tt.setting)
+			req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+			req.Host = "internal:8080"
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var spec Swagger
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+			assert.Equal(t, tt.host, spec.Host)
+			assert.Equal(t, tt.basePath, spec.BasePath)
+			assert.Equal(t, tt.schemes, spec.Schemes)
+			assert.Equal(t, []string{"http"}, r.GetRaw().Schemes)
+		})
+	}
+
+	t.Run("EmbeddedSpec", func(t *testing.T) {
+		e := echo.New()
+		New(e, "doc/", nil).SetHost(HostSetting{Strategy: HostFixed, Host: "api.example.com", BasePath: "/v1"})
+		req := httptest.NewRequest(echo.GET, "/doc/", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `if (spec &&  false ) {`)
+		assert.Contains(t, rec.Body.String(), `\u0022host\u0022:\u0022api.example.com\u0022`)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		r := New(echo.New(), "doc/", nil)
+		assert.Panics(t, func() {
+			r.SetHost(HostSetting{Strategy: HostCustom})
+		})
+		assert.Panics(t, func() {
+			r.SetHost(HostSetting{Strategy: HostFixed, Schemes: []string{"ftp"}})
+		})
+	})
+}
@@ -0,0 +1,192 @@
+package echoswagger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/labstack/echo"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// LoadSpec loads a Swagger 2.0 document in JSON or YAML, which is usually
+// set by `SetRaw` and implemented by `Implement`.
+func LoadSpec(b []byte) (*Swagger, error) {
+	b, err := toJSON(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var version struct {
+		Swagger string `json:"swagger"`
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(b, &version); err != nil {
+		return nil, err
+	}
+	if version.Swagger != SwaggerVersion {
+		return nil, errors.New("echoswagger: only swagger " + SwaggerVersion + " documents are supported")
+	}
+	spec := &Swagger{}
+	if err := json.Unmarshal(b, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// LoadSpecFile loads a Swagger 2.0 document in JSON or YAML from file.
+func LoadSpecFile(file string) (*Swagger, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return LoadSpec(b)
+}
+
+// toJSON converts b in YAML to JSON, b in JSON is returned as is.
+func toJSON(b []byte) ([]byte, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '{' {
+		return b, nil
+	}
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	v, err := yamlToJSON(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// yamlToJSON converts maps decoded by yaml to maps with string keys.
+func yamlToJSON(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			e, err := yamlToJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(k)] = e
+		}
+		return m, nil
+	case []interface{}:
+		for i := range v {
+			e, err := yamlToJSON(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = e
+		}
+	}
+	return v, nil
+}
+
+// implement registers h as handler of operation with id in spec on e,
+// the route is prefixed by basePath of spec.
+func implement(e *echo.Echo, spec *Swagger, id string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if spec == nil {
+		panic("echoswagger: no spec to implement, set it by SetRaw")
+	}
+	// Echo is released after spec is generated.
+	if e == nil {
+		panic("echoswagger: operation " + id + " can't be implemented after spec is generated")
+	}
+	method, path := findOperation(spec, id)
+	if method == "" {
+		panic("echoswagger: operation " + id + " not found")
+	}
+	echoPath, err := toEchoPath(path)
+	if err != nil {
+		panic("echoswagger: operation " + id + " can't be implemented: " + err.Error())
+	}
+	if base := removeTrailingSlash(spec.BasePath); base != "" && base != "/" {
+		echoPath = connectPath(base, echoPath)
+	}
+	return e.Add(method, echoPath, h, m...)
+}
+
+// findOperation returns method and path of operation with id in spec.
+func findOperation(spec *Swagger, id string) (method, path string) {
+	for k, v := range spec.Paths {
+		p, ok := v.(*Path)
+		if !ok {
+			continue
+		}
+		for _, method := range swaggerMethods {
+			if o := p.operation(method); o != nil && o.OperationID == id {
+				return method, k
+			}
+		}
+	}
+	return "", ""
+}
+
+// toEchoPath converts path of swagger to path of echo,
+// it's the inverse of toSwaggerWildcardPath. A path parameter must end
+// a segment, as parameters of echo end at the next "/".
+func toEchoPath(path string) (string, error) {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		j := strings.Index(s, "{")
+		if j < 0 {
+			if strings.Contains(s, "}") {
+				return "", fmt.Errorf("invalid path %s", path)
+			}
+			continue
+		}
+		name := s[j+1:]
+		if !strings.HasSuffix(name, "}") || strings.ContainsAny(name[:len(name)-1], "{}") {
+			return "", fmt.Errorf("path parameter of %s must end a segment", path)
+		}
+		name = name[:len(name)-1]
+		if name == "" {
+			return "", fmt.Errorf("invalid path %s", path)
+		}
+		if j == 0 && i == len(segments)-1 && name == wildcardParam {
+			segments[i] = "*"
+		} else {
+			segments[i] = s[:j] + ":" + name
+		}
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+// unimplemented returns operations of spec which are neither in implemented
+// nor generated from routes, as their operationId, or method and path if
+// they have no operationId.
+func unimplemented(spec *Swagger, implemented map[string]*echo.Route, generated map[*Operation]bool) []string {
+	if spec == nil {
+		return nil
+	}
+	paths := make([]string, 0, len(spec.Paths))
+	for k := range spec.Paths {
+		paths = append(paths, k)
+	}
+	sort.Strings(paths)
+	var ops []string
+	for _, path := range paths {
+		p, ok := spec.Paths[path].(*Path)
+		if !ok {
+			continue
+		}
+		for _, method := range swaggerMethods {
+			o := p.operation(method)
+			switch {
+			case o == nil || generated[o]:
+			case o.OperationID == "":
+				ops = append(ops, method+" "+path)
+			case implemented[o.OperationID] == nil:
+				ops = append(ops, o.OperationID)
+			}
+		}
+	}
+	return ops
+}
@@ -0,0 +1,228 @@
+package echoswagger
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+const designedJSON = `{
+  "swagger": "2.0",
+  "info": {"title": "Pets", "version": "1.0.0", "x-logo": "logo.png"},
+  "paths": {
+    "/pets": {
+      "get": {"operationId": "listPets", "responses": {"200": {"description": "pets"}}},
+      "post": {"operationId": "addPet", "responses": {"201": {"description": "created"}}}
+    },
+    "/pets/{id}": {
+      "get": {
+        "operationId": "getPet",
+        "x-internal": true,
+        "parameters": [{"name": "id", "in": "path", "type": "integer", "required": true, "x-example": 42}],
+        "responses": {"200": {"description": "pet", "schema": {"$ref": "#/definitions/Pet"}}}
+      }
+    },
+    "/health": {
+      "get": {"responses": {"200": {"description": "ok"}}}
+    }
+  },
+  "definitions": {
+    "Pet": {"type": "object", "properties": {"name": {"type": "string", "x-nullable": true}}}
+  }
+}`
+
+const designedYAML = `
+swagger: "2.0"
+info:
+  title: Pets
+  version: 1.0.0
+  x-logo: logo.png
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        200:
+          description: pets
+    post:
+      operationId: addPet
+      responses:
+        201:
+          description: created
+  /pets/{id}:
+    get:
+      operationId: getPet
+      x-internal: true
+      parameters:
+      - name: id
+        in: path
+        type: integer
+        required: true
+        x-example: 42
+      responses:
+        200:
+          description: pet
+          schema:
+            $ref: "#/definitions/Pet"
+  /health:
+    get:
+      responses:
+        200:
+          description: ok
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+        x-nullable: true
+`
+
+func TestLoadSpec(t *testing.T) {
+	spec, err := LoadSpec([]byte(designedJSON))
+	assert.NoError(t, err)
+	yamlSpec, err := LoadSpec([]byte(designedYAML))
+	assert.NoError(t, err)
+	assert.Equal(t, spec, yamlSpec)
+
+	assert.Equal(t, "logo.png", spec.Info.Extensions["x-logo"])
+	if assert.Contains(t, spec.Paths, "/pets/{id}") {
+		o := spec.Paths["/pets/{id}"].(*Path).Get
+		assert.Equal(t, "getPet", o.OperationID)
+		assert.Equal(t, true, o.Extensions["x-internal"])
+		if assert.Len(t, o.Parameters, 1) {
+			assert.Equal(t, float64(42), o.Parameters[0].Extensions["x-example"])
+		}
+		assert.Equal(t, "#/definitions/Pet", o.Responses["200"].Schema.Ref)
+	}
+	assert.Equal(t, true, spec.Definitions["Pet"].Properties["name"].Extensions["x-nullable"])
+
+	b, err := json.Marshal(spec)
+	assert.NoError(t, err)
+	assert.JSONEq(t, designedJSON, string(b))
+
+	_, err = LoadSpec([]byte(`{"openapi": "3.0.0", "paths": {}}`))
+	assert.EqualError(t, err, "echoswagger: only swagger 2.0 documents are supported")
+	_, err = LoadSpec([]byte("swagger: [2.0"))
+	assert.Error(t, err)
+
+	dir, err := ioutil.TempDir("", "echoswagger")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "swagger.yaml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte(designedYAML), 0644))
+	fileSpec, err := LoadSpecFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, spec, fileSpec)
+	_, err = LoadSpecFile(filepath.Join(dir, "missing.yaml"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestImplement(t *testing.T) {
+	spec, err := LoadSpec([]byte(designedJSON))
+	assert.NoError(t, err)
+	e := echo.New()
+	r := New(e, "doc/", nil).SetRaw(spec)
+
+	assert.Equal(t, []string{"GET /health", "listPets", "addPet", "getPet"}, r.Unimplemented())
+
+	r.Implement("getPet", func(c echo.Context) error {
+		return c.String(http.StatusOK, c.Param("id"))
+	})
+	r.Implement("addPet", testHandler, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return c.NoContent(http.StatusCreated)
+		}
+	})
+	r.GET("/health", testHandler)
+	r.GET("/version", testHandler).SetOperationId("getVersion")
+	assert.Equal(t, []string{"GET /health", "listPets"}, r.Unimplemented())
+
+	assert.PanicsWithValue(t, "echoswagger: operation getPet is implemented", func() {
+		r.Implement("getPet", testHandler)
+	})
+	assert.PanicsWithValue(t, "echoswagger: operation deletePet not found", func() {
+		r.Implement("deletePet", testHandler)
+	})
+
+	req := httptest.NewRequest(echo.GET, "/pets/42", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "42", rec.Body.String())
+
+	req = httptest.NewRequest(echo.POST, "/pets", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	s, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+	assert.Len(t, s.Paths, 4)
+	o := s.Paths["/pets/{id}"].(*Path).Get
+	assert.Equal(t, "getPet", o.OperationID)
+	assert.Equal(t, true, o.Extensions["x-internal"])
+	assert.Contains(t, s.Paths, "/version")
+	assert.Contains(t, s.Definitions, "Pet")
+	assert.Equal(t, []string{"listPets"}, r.Unimplemented())
+
+	assert.PanicsWithValue(t, "echoswagger: operation listPets can't be implemented after spec is generated", func() {
+		r.Implement("listPets", testHandler)
+	})
+	assert.PanicsWithValue(t, "echoswagger: no spec to implement, set it by SetRaw", func() {
+		New(echo.New(), "doc/", nil).SetRaw(nil).Implement("getPet", testHandler)
+	})
+}
+
+func TestImplementBasePath(t *testing.T) {
+	spec, err := LoadSpec([]byte(designedJSON))
+	assert.NoError(t, err)
+	spec.BasePath = "/v1/"
+	spec.Paths["/files/{name}.{ext}"] = &Path{Get: &Operation{OperationID: "getFile"}}
+	e := echo.New()
+	r := New(e, "doc/", nil).SetRaw(spec)
+	r.Implement("getPet", func(c echo.Context) error {
+		return c.String(http.StatusOK, c.Param("id"))
+	})
+
+	req := httptest.NewRequest(echo.GET, "/v1/pets/42", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "42", rec.Body.String())
+
+	assert.PanicsWithValue(t, "echoswagger: operation getFile can't be implemented: path parameter of /files/{name}.{ext} must end a segment", func() {
+		r.Implement("getFile", testHandler)
+	})
+}
+
+func TestToEchoPath(t *testing.T) {
+	tests := map[string]string{
+		"/pets":                       "/pets",
+		"/pets/{id}":                  "/pets/:id",
+		"/owners/{owner}/pets/{id}":   "/owners/:owner/pets/:id",
+		"/files/{filepath}":           "/files/*",
+		"/pets/{id}/files/{filepath}": "/pets/:id/files/*",
+	}
+	for path, expected := range tests {
+		p, err := toEchoPath(path)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, p, path)
+		assert.Equal(t, path, toSwaggerWildcardPath(expected), expected)
+	}
+
+	p, err := toEchoPath("/files/user-{id}")
+	assert.NoError(t, err)
+	assert.Equal(t, "/files/user-:id", p)
+	for _, path := range []string{"/files/{name}.{ext}", "/files/{name}.json", "/files/{}", "/files/a}"} {
+		_, err := toEchoPath(path)
+		assert.Error(t, err, path)
+	}
+}
@@ -2,10 +2,15 @@ package echoswagger
 
 import (
 	"bytes"
-	"encoding/json"
+	"errors"
 	"html/template"
 	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/labstack/echo"
 )
@@ -20,57 +25,214 @@ const (
 	ParamInBody     ParamInType = "body"
 )
 
+// UIRenderer is a renderer of the doc page.
+type UIRenderer string
+
+const (
+	UISwaggerUI UIRenderer = "swagger"
+	UIReDoc     UIRenderer = "redoc"
+	UIRapiDoc   UIRenderer = "rapidoc"
+	UIScalar    UIRenderer = "scalar"
+)
+
 type UISetting struct {
+	// DetachSpec loads spec from the spec endpoint instead of embedding it
+	// in the page. Only Swagger UI embeds spec, others always load it.
 	DetachSpec bool
 	HideTop    bool
-	CDN        string
+	// CDN of Swagger UI, DefaultCDN is used if it's empty.
+	CDN string
+	// Script URLs of other renderers, Default...CDN are used if they're empty.
+	ReDocCDN   string
+	RapiDocCDN string
+	ScalarCDN  string
+	// SwaggerUI configures Swagger UI.
+	SwaggerUI SwaggerUIConfig
+	// Renderer rendered at docPath, UISwaggerUI is used if it's empty.
+	Renderer UIRenderer
+	// Template, if not nil, is rendered at docPath instead of Renderer.
+	// It's executed with a map of "title", "specName", "docPath", "subPath"
+	// and "specPath", which is the absolute path of the spec endpoint.
+	Template *template.Template
+	// SpecEncoders pre-compress specs for content encodings, e.g.
+	// {"gzip": GzipEncoder}, which are served to clients accepting them.
+	SpecEncoders map[string]Encoder
+	// Auth protects doc endpoints.
+	Auth DocAuth
+	// SubPaths mounts renderers under sub-paths of docPath, e.g.
+	// {"redoc": UIReDoc} serves ReDoc at "<docPath>/redoc".
+	SubPaths map[string]UIRenderer
+	// Coverage serves the report of `Coverage` at "<docPath>/coverage.json".
+	// It lists routes registered directly on echo, so it's off by default.
+	Coverage bool
 }
 
 type RawDefineDic map[string]RawDefine
 
+// config holds settings shared by Root and all of its groups and apis.
+type config struct {
+	validateTag string
+	// introspection is set by SetIntrospection.
+	introspection bool
+}
+
+// specView is a spec served under docPath besides the spec of Root.
+type specView struct {
+	name string
+	get  func(c echo.Context) (Swagger, error)
+}
+
 type RawDefine struct {
 	Value  reflect.Value
 	Schema *JSONSchema
 }
 
+var uiTemplates = template.Must(template.New("").Parse(specURLScript +
+	SwaggerUIContent + ReDocContent + RapiDocContent + ScalarContent))
+
 func (r *Root) docHandler(docPath string) echo.HandlerFunc {
-	t, err := template.New("swagger").Parse(SwaggerUIContent)
-	if err != nil {
-		panic(err)
-	}
+	return r.uiHandler(docPath, "", "")
+}
+
+// uiHandler renders renderer, which is mounted at subPath of docPath.
+// Empty renderer means the one set by UISetting for docPath.
+func (r *Root) uiHandler(docPath, subPath string, renderer UIRenderer) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		cdn := r.ui.CDN
-		if cdn == "" {
-			cdn = DefaultCDN
+		t := uiTemplates
+		renderer := renderer
+		if renderer == "" {
+			renderer = r.ui.Renderer
+			if r.ui.Template != nil {
+				t = r.ui.Template
+			}
+		}
+		if renderer == "" {
+			renderer = UISwaggerUI
 		}
 		buf := new(bytes.Buffer)
 		params := map[string]interface{}{
-			"title":    r.spec.Info.Title,
-			"cdn":      cdn,
-			"specName": SpecName,
+			"title":              r.spec.Info.Title,
+			"cdn":                r.ui.cdn(renderer),
+			"specName":           SpecName,
+			"specPath":           connectPath(docPath, SpecName),
+			"oauth2RedirectName": OAuth2RedirectName,
+			"subPath":            subPath,
+			"docPath":            docPath,
+		}
+		if renderer == UISwaggerUI {
+			opts, err := r.ui.SwaggerUI.options()
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			params["config"] = opts
+			params["initOAuth"] = r.ui.SwaggerUI.InitOAuth
+		}
+		if len(r.specs) > 0 {
+			urls := []map[string]string{{"name": r.spec.Info.Title, "path": SpecName}}
+			for _, v := range r.specs {
+				urls = append(urls, map[string]string{"name": v.name, "path": v.name + "/" + SpecName})
+			}
+			params["urls"] = urls
 		}
-		if !r.ui.DetachSpec {
-			spec, err := r.GetSpec(c, docPath)
+		if !r.ui.DetachSpec && len(r.specs) == 0 && t == uiTemplates && renderer == UISwaggerUI {
+			spec, err := r.rawSpec(c)
 			if err != nil {
 				return c.String(http.StatusInternalServerError, err.Error())
 			}
-			b, err := json.Marshal(spec)
+			if r.host.Strategy != HostFromReferer {
+				r.resolveHost(c, docPath, "", &spec)
+			}
+			e, _, err := r.cache.load(specCacheKey("", spec), func() (interface{}, error) {
+				return r.applyHooks(spec)
+			})
 			if err != nil {
 				return c.String(http.StatusInternalServerError, err.Error())
 			}
-			params["spec"] = string(b)
-			params["docPath"] = docPath
+			params["spec"] = string(e.body)
 			params["hideTop"] = true
+			params["rewriteHost"] = r.host.Strategy == HostFromReferer
 		} else {
 			params["hideTop"] = r.ui.HideTop
 		}
-		if err := t.Execute(buf, params); err != nil {
+		var err error
+		if t == uiTemplates {
+			err = t.ExecuteTemplate(buf, string(renderer), params)
+		} else {
+			err = t.Execute(buf, params)
+		}
+		if err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
 		return c.HTMLBlob(http.StatusOK, buf.Bytes())
 	}
 }
 
+// addSpecView serves spec returned by get at "<docPath>/<name>/swagger.json",
+// with hooks of owner applied, and lists it in the spec selector of Swagger UI.
+func (r *Root) addSpecView(name string, owner *Root, get func(c echo.Context) (Swagger, error)) {
+	if name == "" || strings.Contains(name, "/") || name == SpecName || name == OAuth2RedirectName || name == CoverageName {
+		panic("echoswagger: invalid spec name")
+	}
+	for _, v := range r.specs {
+		if v.name == name {
+			panic("echoswagger: duplicated spec name " + name)
+		}
+	}
+	r.specs = append(r.specs, specView{name: name, get: get})
+	specPath := connectPath(r.docPath, name, SpecName)
+	r.docGET(specPath, r.specViewHandler(r.docPath, specPath, owner, get))
+}
+
+// docGET registers a doc route, which is protected by doc middlewares.
+func (r *Root) docGET(path string, h echo.HandlerFunc) {
+	r.echo.GET(path, h, r.docMiddlewares...)
+	r.docRoutes = append(r.docRoutes, path)
+	r.owned.add(echo.GET, path)
+}
+
+// docGETOnce registers doc endpoint path like docGET, unless it's registered.
+// Echo is released after spec is generated, then routes can't be added.
+func (r *Root) docGETOnce(path string, h echo.HandlerFunc) {
+	if contains(r.docRoutes, path) {
+		return
+	}
+	if r.echo == nil {
+		panic("echoswagger: doc endpoint " + path + " can't be added after spec is generated")
+	}
+	r.docGET(path, h)
+}
+
+// subPathHandler renders the renderer mounted at subPath by the current
+// UISetting, so sub-paths removed by SetUI are not found.
+func (r *Root) subPathHandler(subPath string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		for sub, renderer := range r.ui.SubPaths {
+			if strings.Trim(sub, "/") == subPath {
+				return r.uiHandler(r.docPath, subPath, renderer)(c)
+			}
+		}
+		return echo.ErrNotFound
+	}
+}
+
+func oauth2RedirectHandler(c echo.Context) error {
+	return c.HTML(http.StatusOK, OAuth2RedirectContent)
+}
+
+func (u UISetting) cdn(renderer UIRenderer) string {
+	cdns := map[UIRenderer][2]string{
+		UISwaggerUI: {u.CDN, DefaultCDN},
+		UIReDoc:     {u.ReDocCDN, DefaultReDocCDN},
+		UIRapiDoc:   {u.RapiDocCDN, DefaultRapiDocCDN},
+		UIScalar:    {u.ScalarCDN, DefaultScalarCDN},
+	}
+	c := cdns[renderer]
+	if c[0] != "" {
+		return c[0]
+	}
+	return c[1]
+}
+
 func (r *RawDefineDic) getKey(v reflect.Value) (bool, string) {
 	for k, d := range *r {
 		if reflect.DeepEqual(d.Value.Interface(), v.Interface()) {
@@ -86,27 +248,120 @@ func (r *RawDefineDic) getKey(v reflect.Value) (bool, string) {
 	return false, name
 }
 
-func (r *routers) appendRoute(route *echo.Route) *api {
+// appendRoute creates Api of route registered with middleware m.
+func (r *routers) appendRoute(route *echo.Route, m []echo.MiddlewareFunc) *api {
 	opr := Operation{
 		Responses: make(map[string]*Response),
 	}
 	a := api{
 		route:     route,
 		defs:      r.defs,
+		conf:      r.conf,
 		operation: opr,
 	}
+	r.owned.add(route.Method, route.Path)
+	if r.conf.introspection {
+		a.introspect = true
+		a.middleware = append(append([]string(nil), r.middleware...), funcNames(m)...)
+		a.source = callerSource()
+	}
 	r.apis = append(r.apis, a)
 	return &r.apis[len(r.apis)-1]
 }
 
+// appendRoutes creates Api of routes registered with middleware m, routes of
+// methods which swagger can't describe are hidden if hideUnsupported is true.
+func (r *routers) appendRoutes(routes []*echo.Route, m []echo.MiddlewareFunc, hideUnsupported bool) *multiApi {
+	start := len(r.apis)
+	for _, route := range routes {
+		r.appendRoute(route, m)
+	}
+	ma := &multiApi{}
+	for i := range routes {
+		a := &r.apis[start+i]
+		if hideUnsupported && !isValidMethod(a.route.Method) {
+			a.hidden = true
+			continue
+		}
+		ma.apis = append(ma.apis, a)
+	}
+	return ma
+}
+
+// appendStatic registers routes serving static files under prefix by get,
+// and creates Api of them.
+func (r *routers) appendStatic(prefix string, get func(path string) *echo.Route) *multiApi {
+	m := r.appendRoutes([]*echo.Route{get(prefix), get(staticWildcardPath(prefix))}, nil, false)
+	for _, a := range m.apis {
+		a.setFileDownload()
+	}
+	m.apis[1].AddParamPath("", wildcardParam, "Path of the file")
+	m.apis[1].wildcard = true
+	return m
+}
+
+// setTags sets tag of the group to all operations of m.
+func (g *group) setTags(m *multiApi) Api {
+	for _, a := range m.apis {
+		a.operation.Tags = []string{g.tag.Name}
+	}
+	return m
+}
+
+// setFileDownload documents operation as downloading a file.
+func (a *api) setFileDownload() {
+	a.operation.Produces = []string{"application/octet-stream"}
+	a.operation.Responses[strconv.Itoa(http.StatusOK)] = &Response{
+		Description: "file",
+		Schema:      &JSONSchema{Type: "file"},
+	}
+}
+
+// staticHandler serves files under root, like handler of `Echo#Static()`.
+func staticHandler(root string) echo.HandlerFunc {
+	if root == "" {
+		root = "." // For security we want to restrict to CWD.
+	}
+	return func(c echo.Context) error {
+		p, err := url.PathUnescape(c.Param("*"))
+		if err != nil {
+			return err
+		}
+		name := filepath.Join(root, path.Clean("/"+p)) // "/"+ for security
+		return c.File(name)
+	}
+}
+
+func fileHandler(file string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.File(file)
+	}
+}
+
+func staticWildcardPath(prefix string) string {
+	if prefix == "/" {
+		return prefix + "*"
+	}
+	return prefix + "/*"
+}
+
+func firstRoute(routes []*echo.Route) *echo.Route {
+	if len(routes) == 0 {
+		return nil
+	}
+	return routes[0]
+}
+
 func (g *api) addParams(p interface{}, in ParamInType, name, desc string, required, nest bool) Api {
-	if !isValidParam(reflect.TypeOf(p), nest, false) {
+	if !isValidParam(reflect.TypeOf(p), in, nest, false) {
 		panic("echoswagger: invalid " + string(in) + " param")
 	}
 	rt := indirectType(p)
-	st, sf := toSwaggerType(rt)
+	st, sf := toParamType(rt, in)
 	if st == "object" && sf == "object" {
-		g.operation.handleParamStruct(rt, in)
+		if err := g.operation.handleParamStruct(rt, in, g.conf, ""); err != nil {
+			panic(err)
+		}
 	} else {
 		name = g.operation.rename(name)
 		pm := &Parameter{
@@ -117,7 +372,7 @@ func (g *api) addParams(p interface{}, in ParamInType, name, desc string, requir
 			Type:        st,
 		}
 		if st == "array" {
-			pm.Items = Items{}.generate(rt.Elem())
+			pm.Items = Items{}.generate(rt.Elem(), in)
 			pm.CollectionFormat = "multi"
 		} else {
 			pm.Format = sf
@@ -137,18 +392,74 @@ func (g *api) addBodyParams(p interface{}, name, desc string, required bool) Api
 		}
 	}
 
-	rv := indirectValue(p)
+	schema, err := g.defs.genSchema(indirectValue(p), g.conf)
+	if err != nil {
+		panic(err)
+	}
 	pm := &Parameter{
 		Name:        name,
 		In:          string(ParamInBody),
 		Description: desc,
 		Required:    required,
-		Schema:      g.defs.genSchema(rv),
+		Schema:      schema,
+	}
+	g.operation.Parameters = append(g.operation.Parameters, pm)
+	return g
+}
+
+func (g *api) addFormJSONParams(p interface{}, name, desc string, required bool) Api {
+	if !isValidSchema(reflect.TypeOf(p), false) {
+		panic("echoswagger: invalid formData JSON param")
+	}
+	schema, err := g.defs.genSchema(indirectValue(p), g.conf)
+	if err != nil {
+		panic(err)
+	}
+	pm := &Parameter{
+		Name:        g.operation.rename(name),
+		In:          string(ParamInFormData),
+		Description: desc,
+		Required:    required,
+		Type:        "string",
+		Extensions:  map[string]interface{}{"x-schema": schema},
 	}
+	pm.setContentType([]string{echo.MIMEApplicationJSON})
 	g.operation.Parameters = append(g.operation.Parameters, pm)
 	return g
 }
 
+// setContentType sets content types accepted by formData parameter p.
+func (p *Parameter) setContentType(types []string) {
+	if p.Extensions == nil {
+		p.Extensions = make(map[string]interface{})
+	}
+	p.Extensions["x-content-type"] = strings.Join(types, ", ")
+}
+
+// isUpload reports whether p is a file, or a part with content types,
+// which must be sent in multipart request.
+func (p *Parameter) isUpload() bool {
+	if p.In != string(ParamInFormData) {
+		return false
+	}
+	_, ok := p.Extensions["x-content-type"]
+	return ok || p.Type == "file" || p.Items != nil && p.Items.Type == "file"
+}
+
+// addMultipartConsumes makes operation with uploads consume multipart
+// request, unless its request content types or consumes of spec are set.
+func (o *Operation) addMultipartConsumes(consumes []string) {
+	if len(o.Consumes) > 0 || contains(consumes, echo.MIMEMultipartForm) {
+		return
+	}
+	for _, p := range o.Parameters {
+		if p.isUpload() {
+			o.Consumes = []string{echo.MIMEMultipartForm}
+			return
+		}
+	}
+}
+
 func (o Operation) rename(s string) string {
 	for _, p := range o.Parameters {
 		if p.Name == s {
@@ -158,16 +469,106 @@ func (o Operation) rename(s string) string {
 	return s
 }
 
-func (o *Operation) handleParamStruct(rt reflect.Type, in ParamInType) {
-	for i := 0; i < rt.NumField(); i++ {
-		if rt.Field(i).Type.Kind() == reflect.Struct && rt.Field(i).Anonymous {
-			o.handleParamStruct(rt.Field(i).Type, in)
-		} else {
-			pm := Parameter{}.generate(rt.Field(i), in)
-			if pm != nil {
-				pm.Name = o.rename(pm.Name)
-				o.Parameters = append(o.Parameters, pm)
+// handleParamStruct adds fields of rt as parameters, with names prefixed by prefix.
+// pres are structs of the prefixes, a struct can't be prefixed by itself.
+func (o *Operation) handleParamStruct(rt reflect.Type, in ParamInType, conf *config, prefix string, pres ...reflect.Type) error {
+	pres = append(pres, rt)
+	for _, pf := range paramFields(rt, in) {
+		if p := paramPrefix(pf.field, in); p != "" {
+			ft := pf.field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if containsType(pres, ft) {
+				return errors.New("echoswagger: recursive " + string(in) + " param struct " + ft.Name() +
+					" in field " + pf.owner.Name() + "." + pf.field.Name)
 			}
+			if err := o.handleParamStruct(ft, in, conf, prefix+p+".", pres...); err != nil {
+				return err
+			}
+			continue
+		}
+		pm, err := Parameter{}.generate(pf.owner, pf.field, in, conf)
+		if err != nil {
+			return err
+		}
+		if pm != nil {
+			pm.Name = o.rename(prefix + pm.Name)
+			o.Parameters = append(o.Parameters, pm)
 		}
 	}
+	return nil
+}
+
+type paramField struct {
+	owner reflect.Type
+	field reflect.StructField
+	depth int
+}
+
+// paramFields returns fields of struct rt for parameters. Fields of embedded
+// structs are promoted and shadowed as Go does: the shallowest one wins, and
+// ones with the same name at the same depth are all dropped.
+func paramFields(rt reflect.Type, in ParamInType) []paramField {
+	var fields []paramField
+	var collect func(t reflect.Type, depth int, visited []reflect.Type)
+	collect = func(t reflect.Type, depth int, visited []reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if isHiddenField(f) {
+				continue
+			}
+			if !isEmbeddedStruct(f, in) {
+				fields = append(fields, paramField{owner: t, field: f, depth: depth})
+				continue
+			}
+			et := f.Type
+			for et.Kind() == reflect.Ptr {
+				et = et.Elem()
+			}
+			if !containsType(visited, et) {
+				collect(et, depth+1, append(visited, et))
+			}
+		}
+	}
+	collect(rt, 0, []reflect.Type{rt})
+
+	depths := make(map[string]int)
+	counts := make(map[string]int)
+	for _, f := range fields {
+		d, ok := depths[f.field.Name]
+		if !ok || f.depth < d {
+			depths[f.field.Name] = f.depth
+			counts[f.field.Name] = 1
+		} else if f.depth == d {
+			counts[f.field.Name]++
+		}
+	}
+	var r []paramField
+	for _, f := range fields {
+		if f.depth == depths[f.field.Name] && counts[f.field.Name] == 1 {
+			r = append(r, f)
+		}
+	}
+	return r
+}
+
+// paramPrefix returns the name in tag of a struct field f, which is used as
+// prefix of it's fields' names like "<prefix>.<name>".
+// Only query and formData parameters support prefix.
+func paramPrefix(f reflect.StructField, in ParamInType) string {
+	if (in != ParamInQuery && in != ParamInFormData) || !isStructType(f.Type) {
+		return ""
+	}
+	name, hasTag := getFieldName(f, in)
+	if !hasTag || name == "-" {
+		return ""
+	}
+	return name
+}
+
+// isEmbeddedStruct reports whether f is an embedded struct or struct pointer
+// whose fields are promoted.
+func isEmbeddedStruct(f reflect.StructField, in ParamInType) bool {
+	return f.Anonymous && isStructType(f.Type) && paramPrefix(f, in) == ""
 }
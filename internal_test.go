@@ -318,3 +318,109 @@ func TestSchemaNestedStruct(t *testing.T) {
 	assert.NotNil(t, (*sapi.defs)["User"])
 	assert.NotNil(t, (*sapi.defs)["User"].Schema.Properties["ExpiredAt"])
 }
+
+func TestEmbeddedParamTypes(t *testing.T) {
+	type Pagination struct {
+		Page int `query:"page" form:"page"`
+		Size int `query:"size" form:"size"`
+	}
+	type Sort struct {
+		Size  int    `query:"sortSize" form:"sortSize"`
+		Order string `query:"order" form:"order"`
+	}
+	type Filter struct {
+		Name string `query:"name" form:"name"`
+		*Pagination
+	}
+	type Search struct {
+		Q string `query:"q" form:"q"`
+		*Pagination
+		Sort
+		Filter Filter `query:"filter" form:"filter"`
+	}
+	type Recursive struct {
+		Name string `query:"name"`
+		*Recursive
+	}
+
+	t.Run("Query", func(t *testing.T) {
+		a := prepareApi()
+		a.AddParamQueryNested(&Search{})
+		var params []string
+		for _, p := range a.(*api).operation.Parameters {
+			params = append(params, p.Name)
+		}
+		// Size of Pagination and Sort are at the same depth, both are dropped.
+		assert.Equal(t, []string{"q", "page", "order", "filter.name", "filter.page", "filter.size"}, params)
+	})
+
+	t.Run("FormData", func(t *testing.T) {
+		a := prepareApi()
+		a.AddParamFormNested(Search{})
+		assert.Len(t, a.(*api).operation.Parameters, 6)
+		assert.Equal(t, "filter.size", a.(*api).operation.Parameters[5].Name)
+	})
+
+	t.Run("Shadowing", func(t *testing.T) {
+		type Outer struct {
+			Page string `query:"p"`
+			Pagination
+		}
+		a := prepareApi()
+		a.AddParamQueryNested(&Outer{})
+		ps := a.(*api).operation.Parameters
+		assert.Len(t, ps, 2)
+		assert.Equal(t, "p", ps[0].Name)
+		assert.Equal(t, "string", ps[0].Type)
+		assert.Equal(t, "size", ps[1].Name)
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		a := prepareApi()
+		a.AddParamQueryNested(&Recursive{})
+		assert.Len(t, a.(*api).operation.Parameters, 1)
+	})
+
+	t.Run("RecursivePrefix", func(t *testing.T) {
+		type Node struct {
+			Name  string `query:"name"`
+			Child *Node  `query:"child"`
+		}
+		type Tree struct {
+			Root Node `query:"root"`
+		}
+		for _, p := range []interface{}{&Node{}, &Tree{}} {
+			func() {
+				defer func() {
+					err, ok := recover().(error)
+					if assert.True(t, ok) {
+						assert.EqualError(t, err, "echoswagger: recursive query param struct Node in field Node.Child")
+					}
+				}()
+				prepareApi().AddParamQueryNested(p)
+			}()
+		}
+	})
+
+	t.Run("Path", func(t *testing.T) {
+		a := prepareApi()
+		assert.Panics(t, func() {
+			a.AddParamPathNested(&Search{})
+		})
+		type Path struct {
+			*Pagination
+		}
+		a.AddParamPathNested(&Path{})
+		assert.Len(t, a.(*api).operation.Parameters, 2)
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		type Header struct {
+			RequestId string `json:"X-Request-Id"`
+			*Pagination
+		}
+		a := prepareApi()
+		a.AddResponse(200, "", nil, &Header{})
+		assert.Len(t, a.(*api).operation.Responses["200"].Headers, 3)
+	})
+}
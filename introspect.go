@@ -0,0 +1,73 @@
+package echoswagger
+
+import (
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo"
+)
+
+// pkgDir is the directory of source files of this package.
+var pkgDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// funcNames returns names of middleware m, resolved like `echo.Route.Name`.
+func funcNames(m []echo.MiddlewareFunc) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	names := make([]string, len(m))
+	for i, f := range m {
+		names[i] = handlerName(f)
+	}
+	return names
+}
+
+// handlerName returns name of function h, like `echo.Route.Name`.
+func handlerName(h interface{}) string {
+	if fn := runtime.FuncForPC(reflect.ValueOf(h).Pointer()); fn != nil {
+		return fn.Name()
+	}
+	return ""
+}
+
+// callerSource returns "file:line" of the first caller outside this package,
+// where a route is registered. Test files of this package are callers.
+func callerSource() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		f, more := frames.Next()
+		if filepath.Dir(f.File) != pkgDir || strings.HasSuffix(f.File, "_test.go") {
+			return f.File + ":" + strconv.Itoa(f.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// addIntrospection sets "x-handler", "x-middleware" and "x-source" of
+// operation of a, if introspection was enabled when a was registered.
+// "x-middleware" doesn't contain middleware added by `Use` of echo.
+func (a *api) addIntrospection() {
+	if !a.introspect {
+		return
+	}
+	o := &a.operation
+	if o.Extensions == nil {
+		o.Extensions = make(map[string]interface{})
+	}
+	o.Extensions["x-handler"] = a.route.Name
+	if len(a.middleware) > 0 {
+		o.Extensions["x-middleware"] = a.middleware
+	}
+	if a.source != "" {
+		o.Extensions["x-source"] = a.source
+	}
+}
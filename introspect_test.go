@@ -0,0 +1,48 @@
+package echoswagger
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMiddleware(next echo.HandlerFunc) echo.HandlerFunc { return next }
+
+func TestIntrospection(t *testing.T) {
+	e := echo.New()
+	r := New(e, "doc/", nil).SetDiscovery(DiscoveryInclude)
+	r.GET("/health", testHandler)
+	r.SetIntrospection(true)
+	g := r.Group("Pets", "/pets", testMiddleware)
+	_, file, line, _ := runtime.Caller(0)
+	g.GET("/:id", testHandler, func(next echo.HandlerFunc) echo.HandlerFunc { return next })
+	r.Match([]string{echo.PUT, echo.PATCH}, "/owners/:id", testHandler)
+	e.DELETE("/pets/:id", testHandler)
+
+	spec, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+
+	assert.Nil(t, spec.Paths["/health"].(*Path).Get.Extensions)
+
+	pet := spec.Paths["/pets/{id}"].(*Path)
+	assert.Equal(t, map[string]interface{}{
+		"x-handler": "github.com/pangpanglabs/echoswagger.testHandler",
+		"x-middleware": []string{
+			"github.com/pangpanglabs/echoswagger.testMiddleware",
+			"github.com/pangpanglabs/echoswagger.TestIntrospection.func1",
+		},
+		"x-source": file + ":" + strconv.Itoa(line+1),
+	}, pet.Get.Extensions)
+	assert.Equal(t, map[string]interface{}{
+		"x-handler": "github.com/pangpanglabs/echoswagger.testHandler",
+	}, pet.Delete.Extensions)
+
+	owner := spec.Paths["/owners/{id}"].(*Path)
+	for _, o := range []*Operation{owner.Put, owner.Patch} {
+		assert.Equal(t, file+":"+strconv.Itoa(line+2), o.Extensions["x-source"])
+		assert.NotContains(t, o.Extensions, "x-middleware")
+	}
+}
@@ -0,0 +1,229 @@
+package echoswagger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseFragment decodes a spec fragment in JSON or YAML, which must be an object.
+func parseFragment(b []byte) (map[string]interface{}, error) {
+	b, err := toJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	var fragment map[string]interface{}
+	if err := json.Unmarshal(b, &fragment); err != nil {
+		return nil, err
+	}
+	if fragment == nil {
+		return nil, errors.New("fragment isn't an object")
+	}
+	return fragment, nil
+}
+
+// mergeFragments merges fragments added by MergeSpec into the generated spec.
+// Operations are kept as the same pointers, which audiences are bound to.
+func (r *Root) mergeFragments() error {
+	if len(r.fragments) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(r.spec)
+	if err != nil {
+		return err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+	var conflicts []string
+	for _, f := range r.fragments {
+		mergeJSON(doc, f, "", &conflicts)
+	}
+	if len(conflicts) > 0 {
+		return errors.New("echoswagger: spec fragments conflict with spec: " + strings.Join(conflicts, "; "))
+	}
+
+	if b, err = json.Marshal(doc); err != nil {
+		return err
+	}
+	spec := &Swagger{}
+	if err := json.Unmarshal(b, spec); err != nil {
+		return err
+	}
+	if b, err = json.Marshal(spec); err != nil {
+		return err
+	}
+	var merged map[string]interface{}
+	if err := json.Unmarshal(b, &merged); err != nil {
+		return err
+	}
+	var unsupported []string
+	missingJSON(doc, merged, "", &unsupported)
+	if len(unsupported) > 0 {
+		return errors.New("echoswagger: fields of spec fragments aren't supported: " + strings.Join(unsupported, ", "))
+	}
+	for k, v := range spec.Paths {
+		p, ok := r.spec.Paths[k].(*Path)
+		if !ok {
+			continue
+		}
+		np := v.(*Path)
+		for _, method := range swaggerMethods {
+			if o, no := p.operation(method), np.operation(method); o != nil && no != nil {
+				*o = *no
+				np.oprationAssign(method, o)
+			}
+		}
+	}
+	*r.spec = *spec
+	return nil
+}
+
+// mergeJSON merges decoded JSON object src at path into dst. Objects are
+// merged by keys, arrays by elements, and objects in arrays with the same
+// "name" and "in" are merged as the same element, e.g. tags and parameters.
+// Different values at the same key are appended to conflicts.
+func mergeJSON(dst, src map[string]interface{}, path string, conflicts *[]string) {
+	keys := make([]string, 0, len(src))
+	for k := range src {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		sv := src[k]
+		p := fragmentPath(path, k)
+		dv, ok := dst[k]
+		if !ok {
+			dst[k] = sv
+			continue
+		}
+		switch s := sv.(type) {
+		case map[string]interface{}:
+			if d, ok := dv.(map[string]interface{}); ok {
+				mergeJSON(d, s, p, conflicts)
+				continue
+			}
+		case []interface{}:
+			if d, ok := dv.([]interface{}); ok {
+				dst[k] = mergeArray(d, s, p, conflicts)
+				continue
+			}
+		}
+		if !reflect.DeepEqual(dv, sv) {
+			*conflicts = append(*conflicts, fmt.Sprintf("%s: %s != %s", p, compactJSON(dv), compactJSON(sv)))
+		}
+	}
+}
+
+func mergeArray(dst, src []interface{}, path string, conflicts *[]string) []interface{} {
+	for _, sv := range src {
+		i := indexOf(dst, sv)
+		if i < 0 {
+			dst = append(dst, sv)
+			continue
+		}
+		if d, ok := dst[i].(map[string]interface{}); ok {
+			mergeJSON(d, sv.(map[string]interface{}), path+"["+strconv.Itoa(i)+"]", conflicts)
+		}
+	}
+	return dst
+}
+
+// indexOf returns index of the element in a equals to v,
+// or named the same as v if they are objects.
+func indexOf(a []interface{}, v interface{}) int {
+	name := func(v interface{}) (string, bool) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		n, ok := m["name"].(string)
+		in, _ := m["in"].(string)
+		return in + " " + n, ok
+	}
+	n, named := name(v)
+	for i, e := range a {
+		if named {
+			if en, ok := name(e); ok && en == n {
+				return i
+			}
+		} else if reflect.DeepEqual(e, v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// missingJSON appends paths of non-zero values in expected,
+// which are missing in actual, to missing.
+func missingJSON(expected, actual interface{}, path string, missing *[]string) {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, _ := actual.(map[string]interface{})
+		keys := make([]string, 0, len(e))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if av, ok := a[k]; ok {
+				missingJSON(e[k], av, fragmentPath(path, k), missing)
+			} else if !isZeroJSON(e[k]) {
+				*missing = append(*missing, fragmentPath(path, k))
+			}
+		}
+	case []interface{}:
+		a, _ := actual.([]interface{})
+		for i := range e {
+			if i < len(a) {
+				missingJSON(e[i], a[i], path+"["+strconv.Itoa(i)+"]", missing)
+			}
+		}
+	}
+}
+
+// isZeroJSON reports whether decoded JSON value v is omitted as empty.
+func isZeroJSON(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+var fragmentKey = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$-]*$`)
+
+// fragmentPath appends key to path, like `paths["/pets"].get.summary`.
+func fragmentPath(path, key string) string {
+	if !fragmentKey.MatchString(key) {
+		return path + "[" + strconv.Quote(key) + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func compactJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,119 @@
+package echoswagger
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeSpec(t *testing.T) {
+	type Pet struct {
+		Name string `json:"name"`
+	}
+	r := New(echo.New(), "doc/", nil)
+	g := r.Group("Pets", "/pets")
+	g.GET("/:id", testHandler).
+		SetSummary("Get pet").
+		SetAudiences("partner").
+		AddParamPath(0, "id", "").
+		AddResponse(http.StatusOK, "pet", &Pet{}, nil)
+
+	r.MergeSpec([]byte(`{
+  "x-api-id": "pets",
+  "tags": [{"name": "Pets", "description": "Everything about pets"}, {"name": "Owners"}],
+  "paths": {
+    "/pets/{id}": {
+      "get": {
+        "summary": "Get pet",
+        "description": "Returns a single pet",
+        "x-internal": false,
+        "parameters": [{"name": "id", "in": "path", "description": "ID of pet", "x-example": 42}],
+        "responses": {"200": {"examples": {"application/json": {"name": "doggie"}}}}
+      }
+    }
+  }
+}`))
+	r.MergeSpec([]byte(`
+definitions:
+  Error:
+    type: object
+    properties:
+      message:
+        type: string
+`))
+
+	spec, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+	assert.Equal(t, "pets", spec.Extensions["x-api-id"])
+	if assert.Len(t, spec.Tags, 2) {
+		assert.Equal(t, &Tag{Name: "Pets", Description: "Everything about pets"}, spec.Tags[0])
+		assert.Equal(t, "Owners", spec.Tags[1].Name)
+	}
+	o := spec.Paths["/pets/{id}"].(*Path).Get
+	assert.Equal(t, "Get pet", o.Summary)
+	assert.Equal(t, "Returns a single pet", o.Description)
+	assert.Equal(t, false, o.Extensions["x-internal"])
+	if assert.Len(t, o.Parameters, 1) {
+		assert.Equal(t, "ID of pet", o.Parameters[0].Description)
+		assert.Equal(t, float64(42), o.Parameters[0].Extensions["x-example"])
+		assert.Equal(t, "integer", o.Parameters[0].Type)
+	}
+	assert.Equal(t, "pet", o.Responses["200"].Description)
+	assert.Equal(t, map[string]interface{}{"name": "doggie"}, o.Responses["200"].Examples["application/json"])
+	assert.Contains(t, spec.Definitions, "Pet")
+	assert.Contains(t, spec.Definitions, "Error")
+	assert.Equal(t, []string{"partner"}, r.(*Root).audiences[o])
+
+	assert.PanicsWithValue(t, "echoswagger: invalid spec fragment: fragment isn't an object", func() {
+		r.MergeSpec([]byte("null"))
+	})
+	assert.Panics(t, func() {
+		r.MergeSpec([]byte("[]"))
+	})
+	assert.Panics(t, func() {
+		r.MergeSpec([]byte("paths: [/pets"))
+	})
+}
+
+func TestMergeSpecConflict(t *testing.T) {
+	r := New(echo.New(), "doc/", &Info{Title: "Pets", Version: "1.0.0"})
+	r.GET("/pets", testHandler).SetSummary("List pets").AddParamQuery(0, "limit", "", false)
+	r.MergeSpec([]byte(`{"info": {"title": "Pet Store"}}`))
+	r.MergeSpec([]byte(`{
+  "paths": {
+    "/pets": {
+      "get": {
+        "summary": "List all pets",
+        "parameters": [{"name": "limit", "in": "query", "type": "string"}]
+      }
+    }
+  }
+}`))
+
+	_, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.EqualError(t, err, `echoswagger: spec fragments conflict with spec: info.title: "Pets" != "Pet Store"; `+
+		`paths["/pets"].get.parameters[0].type: "integer" != "string"; `+
+		`paths["/pets"].get.summary: "List pets" != "List all pets"`)
+}
+
+func TestMergeSpecUnsupported(t *testing.T) {
+	r := New(echo.New(), "doc/", nil)
+	r.GET("/pets", testHandler)
+	r.MergeSpec([]byte(`{"paths": {"/pets": {"get": {"servers": [{"url": "/v2"}], "deprecated": false}}}, "components": {}}`))
+
+	_, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.EqualError(t, err, `echoswagger: fields of spec fragments aren't supported: paths["/pets"].get.servers`)
+}
+
+func TestMergeSpecAfterGenerated(t *testing.T) {
+	r := New(echo.New(), "doc/", nil)
+	r.GET("/pets", testHandler)
+	_, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+
+	assert.PanicsWithValue(t, "echoswagger: spec fragment can't be merged after spec is generated", func() {
+		r.MergeSpec([]byte(`{"info": {"description": "Pets"}}`))
+	})
+}
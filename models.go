@@ -16,8 +16,10 @@ type (
 		Parameters          map[string]*Parameter          `json:"parameters,omitempty"`
 		Responses           map[string]*Response           `json:"responses,omitempty"`
 		SecurityDefinitions map[string]*SecurityDefinition `json:"securityDefinitions,omitempty"`
+		Security            []map[string][]string          `json:"security,omitempty"`
 		Tags                []*Tag                         `json:"tags,omitempty"`
 		ExternalDocs        *ExternalDocs                  `json:"externalDocs,omitempty"`
+		Extensions          map[string]interface{}         `json:"-"`
 	}
 
 	// Info provides metadata about the API. The metadata can be used by the clients if needed,
@@ -171,6 +173,8 @@ type (
 		Schema *JSONSchema `json:"schema,omitempty"`
 		// Headers is a list of headers that are sent with the response.
 		Headers map[string]*Header `json:"headers,omitempty"`
+		// Examples of the response message, keyed by MIME type.
+		Examples map[string]interface{} `json:"examples,omitempty"`
 		// Ref references a global API response.
 		// This field is exclusive with the other fields of Response.
 		Ref string `json:"$ref,omitempty"`
@@ -208,6 +212,8 @@ type (
 		UniqueItems      bool          `json:"uniqueItems,omitempty"`
 		Enum             []interface{} `json:"enum,omitempty"`
 		MultipleOf       float64       `json:"multipleOf,omitempty"`
+		// Extensions defines the swagger extensions.
+		Extensions map[string]interface{} `json:"-"`
 	}
 
 	// SecurityDefinition allows the definition of a security scheme that can be used by the
@@ -320,14 +326,23 @@ type (
 		Format               string        `json:"format,omitempty"`
 		Pattern              string        `json:"pattern,omitempty"`
 		Minimum              *float64      `json:"minimum,omitempty"`
+		ExclusiveMinimum     bool          `json:"exclusiveMinimum,omitempty"`
 		Maximum              *float64      `json:"maximum,omitempty"`
+		ExclusiveMaximum     bool          `json:"exclusiveMaximum,omitempty"`
+		MultipleOf           float64       `json:"multipleOf,omitempty"`
 		MinLength            *int          `json:"minLength,omitempty"`
 		MaxLength            *int          `json:"maxLength,omitempty"`
+		MinItems             *int          `json:"minItems,omitempty"`
+		MaxItems             *int          `json:"maxItems,omitempty"`
+		UniqueItems          bool          `json:"uniqueItems,omitempty"`
 		Required             []string      `json:"required,omitempty"`
 		AdditionalProperties *JSONSchema   `json:"additionalProperties,omitempty"`
 
 		// Union
 		AnyOf []*JSONSchema `json:"anyOf,omitempty"`
+
+		// Extensions defines the swagger extensions.
+		Extensions map[string]interface{} `json:"-"`
 	}
 
 	// JSONType is the JSON type enum.
@@ -0,0 +1,214 @@
+package echoswagger
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo"
+)
+
+// multiApi is an Api of several routes registered together,
+// e.g. by `Any`, `Match` or `Static`. Its methods apply to all of them.
+type multiApi struct {
+	apis []*api
+}
+
+func (m *multiApi) AddParamPath(p interface{}, name, desc string) Api {
+	for _, a := range m.apis {
+		a.AddParamPath(p, name, desc)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamPathNested(p interface{}) Api {
+	for _, a := range m.apis {
+		a.AddParamPathNested(p)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamQuery(p interface{}, name, desc string, required bool) Api {
+	for _, a := range m.apis {
+		a.AddParamQuery(p, name, desc, required)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamQueryNested(p interface{}) Api {
+	for _, a := range m.apis {
+		a.AddParamQueryNested(p)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamForm(p interface{}, name, desc string, required bool) Api {
+	for _, a := range m.apis {
+		a.AddParamForm(p, name, desc, required)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamFormNested(p interface{}) Api {
+	for _, a := range m.apis {
+		a.AddParamFormNested(p)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamHeader(p interface{}, name, desc string, required bool) Api {
+	for _, a := range m.apis {
+		a.AddParamHeader(p, name, desc, required)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamHeaderNested(p interface{}) Api {
+	for _, a := range m.apis {
+		a.AddParamHeaderNested(p)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamBody(p interface{}, name, desc string, required bool) Api {
+	for _, a := range m.apis {
+		a.AddParamBody(p, name, desc, required)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamFile(name, desc string, required bool) Api {
+	for _, a := range m.apis {
+		a.AddParamFile(name, desc, required)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamFiles(name, desc string, required bool) Api {
+	for _, a := range m.apis {
+		a.AddParamFiles(name, desc, required)
+	}
+	return m
+}
+
+func (m *multiApi) AddParamFormJSON(p interface{}, name, desc string, required bool) Api {
+	for _, a := range m.apis {
+		a.AddParamFormJSON(p, name, desc, required)
+	}
+	return m
+}
+
+func (m *multiApi) SetParamContentType(name string, types ...string) Api {
+	for _, a := range m.apis {
+		a.SetParamContentType(name, types...)
+	}
+	return m
+}
+
+func (m *multiApi) AddResponse(code int, desc string, schema interface{}, header interface{}) Api {
+	for _, a := range m.apis {
+		a.AddResponse(code, desc, schema, header)
+	}
+	return m
+}
+
+func (m *multiApi) SetRequestContentType(types ...string) Api {
+	for _, a := range m.apis {
+		a.SetRequestContentType(types...)
+	}
+	return m
+}
+
+func (m *multiApi) SetResponseContentType(types ...string) Api {
+	for _, a := range m.apis {
+		a.SetResponseContentType(types...)
+	}
+	return m
+}
+
+func (m *multiApi) SetDeprecated() Api {
+	for _, a := range m.apis {
+		a.SetDeprecated()
+	}
+	return m
+}
+
+func (m *multiApi) SetDescription(desc string) Api {
+	for _, a := range m.apis {
+		a.SetDescription(desc)
+	}
+	return m
+}
+
+func (m *multiApi) SetExternalDocs(desc, url string) Api {
+	for _, a := range m.apis {
+		a.SetExternalDocs(desc, url)
+	}
+	return m
+}
+
+func (m *multiApi) SetSummary(summary string) Api {
+	for _, a := range m.apis {
+		a.SetSummary(summary)
+	}
+	return m
+}
+
+func (m *multiApi) SetSecurity(names ...string) Api {
+	for _, a := range m.apis {
+		a.SetSecurity(names...)
+	}
+	return m
+}
+
+func (m *multiApi) SetSecurityWithScope(s map[string][]string) Api {
+	for _, a := range m.apis {
+		a.SetSecurityWithScope(s)
+	}
+	return m
+}
+
+func (m *multiApi) Hidden() Api {
+	for _, a := range m.apis {
+		a.Hidden()
+	}
+	return m
+}
+
+func (m *multiApi) SetAudiences(audiences ...string) Api {
+	for _, a := range m.apis {
+		a.SetAudiences(audiences...)
+	}
+	return m
+}
+
+// SetOperationId sets operationId, which is suffixed by method of
+// each route if there're more than one routes, and then by index
+// of the route among the ones of the same method, e.g. routes of `Static`.
+func (m *multiApi) SetOperationId(id string) Api {
+	counts := make(map[string]int)
+	for _, a := range m.apis {
+		counts[a.route.Method]++
+	}
+	indexes := make(map[string]int)
+	for _, a := range m.apis {
+		if len(m.apis) == 1 {
+			a.SetOperationId(id)
+			continue
+		}
+		s := id + "_" + strings.ToLower(a.route.Method)
+		if counts[a.route.Method] > 1 {
+			indexes[a.route.Method]++
+			s += "_" + strconv.Itoa(indexes[a.route.Method])
+		}
+		a.SetOperationId(s)
+	}
+	return m
+}
+
+// Route returns the first route.
+func (m *multiApi) Route() *echo.Route {
+	if len(m.apis) == 0 {
+		return nil
+	}
+	return m.apis[0].route
+}
@@ -6,6 +6,9 @@ import (
 
 type NopRoot struct {
 	echo *echo.Echo
+	// spec and implemented are kept to register routes by Implement.
+	spec        *Swagger
+	implemented map[string]*echo.Route
 }
 
 var _ ApiRoot = NewNop(nil)
@@ -74,6 +77,30 @@ func (r *NopRoot) SetExternalDocs(_, _ string) ApiRoot {
 	return r
 }
 
+func (r *NopRoot) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: r.echo.CONNECT(path, h, m...)}
+}
+
+func (r *NopRoot) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: r.echo.TRACE(path, h, m...)}
+}
+
+func (r *NopRoot) Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: firstRoute(r.echo.Any(path, h, m...))}
+}
+
+func (r *NopRoot) Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: firstRoute(r.echo.Match(methods, path, h, m...))}
+}
+
+func (r *NopRoot) Static(prefix, root string) Api {
+	return &nopApi{route: r.echo.Static(prefix, root)}
+}
+
+func (r *NopRoot) File(path, file string, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: r.echo.File(path, file, m...)}
+}
+
 func (r *NopRoot) AddSecurityBasic(_, _ string) ApiRoot {
 	return r
 }
@@ -95,14 +122,63 @@ func (r *NopRoot) SetScheme(_ ...string) ApiRoot {
 	return r
 }
 
+func (r *NopRoot) SetHost(_ HostSetting) ApiRoot {
+	return r
+}
+
+func (r *NopRoot) SetDiscovery(_ DiscoveryMode) ApiRoot {
+	return r
+}
+
+func (r *NopRoot) Coverage() (*CoverageReport, error) {
+	return &CoverageReport{}, nil
+}
+
+func (r *NopRoot) SetIntrospection(_ bool) ApiRoot {
+	return r
+}
+
+func (r *NopRoot) SetValidateTag(_ string) ApiRoot {
+	return r
+}
+
+func (r *NopRoot) AddAudienceSpec(_ string) ApiRoot {
+	return r
+}
+
+func (r *NopRoot) AddSpec(_ string, _ ApiRoot) ApiRoot {
+	return r
+}
+
 func (r *NopRoot) GetRaw() *Swagger {
-	return nil
+	return r.spec
+}
+
+func (r *NopRoot) SetRaw(s *Swagger) ApiRoot {
+	r.spec = s
+	return r
+}
+
+func (r *NopRoot) MergeSpec(_ []byte) ApiRoot {
+	return r
 }
 
-func (r *NopRoot) SetRaw(_ *Swagger) ApiRoot {
+func (r *NopRoot) OnSpec(_ func(*Swagger) error) ApiRoot {
 	return r
 }
 
+func (r *NopRoot) Implement(operationId string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) ApiRoot {
+	if r.implemented == nil {
+		r.implemented = make(map[string]*echo.Route)
+	}
+	r.implemented[operationId] = implement(r.echo, r.spec, operationId, h, m...)
+	return r
+}
+
+func (r *NopRoot) Unimplemented() []string {
+	return unimplemented(r.spec, r.implemented, nil)
+}
+
 func (r *NopRoot) Echo() *echo.Echo {
 	return r.echo
 }
@@ -139,6 +215,32 @@ func (g *nopGroup) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFu
 	return &nopApi{route: g.echoGroup.PATCH(path, h, m...)}
 }
 
+func (g *nopGroup) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: g.echoGroup.CONNECT(path, h, m...)}
+}
+
+func (g *nopGroup) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: g.echoGroup.TRACE(path, h, m...)}
+}
+
+func (g *nopGroup) Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: firstRoute(g.echoGroup.Any(path, h, m...))}
+}
+
+func (g *nopGroup) Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: firstRoute(g.echoGroup.Match(methods, path, h, m...))}
+}
+
+func (g *nopGroup) Static(prefix, root string) Api {
+	h := staticHandler(root)
+	g.echoGroup.GET(prefix, h)
+	return &nopApi{route: g.echoGroup.GET(staticWildcardPath(prefix), h)}
+}
+
+func (g *nopGroup) File(path, file string, m ...echo.MiddlewareFunc) Api {
+	return &nopApi{route: g.echoGroup.GET(path, fileHandler(file), m...)}
+}
+
 func (g *nopGroup) SetDescription(_ string) ApiGroup {
 	return g
 }
@@ -147,6 +249,14 @@ func (g *nopGroup) SetExternalDocs(_, _ string) ApiGroup {
 	return g
 }
 
+func (g *nopGroup) Hidden() ApiGroup {
+	return g
+}
+
+func (g *nopGroup) SetAudiences(_ ...string) ApiGroup {
+	return g
+}
+
 func (g *nopGroup) SetSecurity(_ ...string) ApiGroup {
 	return g
 }
@@ -199,6 +309,18 @@ func (a *nopApi) AddParamFile(_, _ string, _ bool) Api {
 	return a
 }
 
+func (a *nopApi) AddParamFiles(_, _ string, _ bool) Api {
+	return a
+}
+
+func (a *nopApi) AddParamFormJSON(_ interface{}, _, _ string, _ bool) Api {
+	return a
+}
+
+func (a *nopApi) SetParamContentType(_ string, _ ...string) Api {
+	return a
+}
+
 func (a *nopApi) SetRequestContentType(_ ...string) Api {
 	return a
 }
@@ -227,6 +349,14 @@ func (a *nopApi) SetExternalDocs(_, _ string) Api {
 	return a
 }
 
+func (a *nopApi) Hidden() Api {
+	return a
+}
+
+func (a *nopApi) SetAudiences(_ ...string) Api {
+	return a
+}
+
 func (a *nopApi) SetSummary(_ string) Api {
 	return a
 }
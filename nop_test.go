@@ -56,7 +56,25 @@ func TestNop(t *testing.T) {
 	assert.Equal(t, a.Route().Name, expectHandler)
 	assert.Equal(t, a.Route().Path, path)
 
+	a = r.TRACE(path, testHandler)
+	assert.Equal(t, a.Route().Method, http.MethodTrace)
+
+	a = r.CONNECT(path, testHandler)
+	assert.Equal(t, a.Route().Method, http.MethodConnect)
+
+	a = r.Match([]string{http.MethodGet}, path, testHandler)
+	assert.Equal(t, a.Route().Method, http.MethodGet)
+	assert.NotNil(t, r.Any(path, testHandler).Route())
+	assert.NotNil(t, r.Static("/static", "").Route())
+	assert.Equal(t, r.File("/file", "file").Route().Path, "/file")
+
 	g := r.Group("", "g/")
+	assert.Equal(t, g.TRACE(path, testHandler).Route().Method, http.MethodTrace)
+	assert.Equal(t, g.CONNECT(path, testHandler).Route().Method, http.MethodConnect)
+	assert.NotNil(t, g.Any(path, testHandler).Route())
+	assert.NotNil(t, g.Match([]string{http.MethodGet}, path, testHandler).Route())
+	assert.Equal(t, g.Static("/static", "").Route().Path, "g//static/*")
+	assert.NotNil(t, g.File("/file", "file").Route())
 	assert.EqualValues(t, g.EchoGroup(), r.Echo().Group("g/"))
 
 	eg := g.EchoGroup()
@@ -70,8 +88,28 @@ func TestNop(t *testing.T) {
 	assert.Equal(t, r.AddSecurityOAuth2("", "", "", "", "", nil), r)
 	assert.Equal(t, r.SetUI(UISetting{}), r)
 	assert.Equal(t, r.SetScheme(), r)
+	assert.Equal(t, r.SetHost(HostSetting{}), r)
+	assert.Equal(t, r.SetDiscovery(DiscoveryInclude), r)
+	report, err := r.Coverage()
+	assert.NoError(t, err)
+	assert.Equal(t, &CoverageReport{}, report)
+	assert.Equal(t, r.SetValidateTag(""), r)
+	assert.Equal(t, r.SetIntrospection(true), r)
+	assert.Equal(t, r.AddAudienceSpec(""), r)
+	assert.Equal(t, r.AddSpec("", nil), r)
 	assert.Nil(t, r.GetRaw())
 	assert.Equal(t, r.SetRaw(nil), r)
+	assert.Equal(t, r.MergeSpec(nil), r)
+	assert.Equal(t, r.OnSpec(nil), r)
+	assert.Nil(t, r.Unimplemented())
+	spec := &Swagger{Paths: map[string]interface{}{
+		"/pets/{id}": &Path{Get: &Operation{OperationID: "getPet"}},
+	}}
+	assert.Equal(t, r.SetRaw(spec), r)
+	assert.Equal(t, spec, r.GetRaw())
+	assert.Equal(t, []string{"getPet"}, r.Unimplemented())
+	assert.Equal(t, r.Implement("getPet", testHandler), r)
+	assert.Empty(t, r.Unimplemented())
 	assert.Equal(t, r.Echo(), e)
 
 	expectPath := "g/" + path
@@ -117,6 +155,8 @@ func TestNop(t *testing.T) {
 
 	assert.Equal(t, g.SetDescription(""), g)
 	assert.Equal(t, g.SetExternalDocs("", ""), g)
+	assert.Equal(t, g.Hidden(), g)
+	assert.Equal(t, g.SetAudiences(), g)
 	assert.Equal(t, g.SetSecurity(), g)
 	assert.Equal(t, g.SetSecurityWithScope(nil), g)
 
@@ -130,6 +170,9 @@ func TestNop(t *testing.T) {
 	assert.Equal(t, a.AddParamHeaderNested(nil), a)
 	assert.Equal(t, a.AddParamBody(nil, "", "", false), a)
 	assert.Equal(t, a.AddParamFile("", "", false), a)
+	assert.Equal(t, a.AddParamFiles("", "", false), a)
+	assert.Equal(t, a.AddParamFormJSON(nil, "", "", false), a)
+	assert.Equal(t, a.SetParamContentType(""), a)
 	assert.Equal(t, a.SetRequestContentType(), a)
 	assert.Equal(t, a.SetResponseContentType(), a)
 	assert.Equal(t, a.AddResponse(0, "", nil, nil), a)
@@ -137,6 +180,8 @@ func TestNop(t *testing.T) {
 	assert.Equal(t, a.SetDeprecated(), a)
 	assert.Equal(t, a.SetDescription(""), a)
 	assert.Equal(t, a.SetExternalDocs("", ""), a)
+	assert.Equal(t, a.Hidden(), a)
+	assert.Equal(t, a.SetAudiences(), a)
 	assert.Equal(t, a.SetSummary(""), a)
 	assert.Equal(t, a.SetSecurity(), a)
 	assert.Equal(t, a.SetSecurityWithScope(nil), a)
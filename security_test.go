@@ -1,6 +1,7 @@
 package echoswagger
 
 import (
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -244,3 +245,55 @@ func TestSecurityRepeat(t *testing.T) {
 		assert.Len(t, se, 6)
 	})
 }
+
+func TestOAuth2Redirect(t *testing.T) {
+	e := echo.New()
+	r := New(e, "doc/", nil)
+
+	req := httptest.NewRequest(echo.GET, "/doc/oauth2-redirect.html", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	r.AddSecurityOAuth2("OAuth2", "OAuth2 Auth", OAuth2FlowAccessCode, "http://localhost:8080/authorize", "http://localhost:8080/token", nil)
+	r.AddSecurityOAuth2("OAuth2Implicit", "", OAuth2FlowImplicit, "http://localhost:8080/authorize", "", nil)
+	redirects := 0
+	for _, route := range e.Routes() {
+		if route.Path == "/doc/oauth2-redirect.html" {
+			redirects++
+		}
+	}
+	assert.Equal(t, 1, redirects)
+	r.SetUI(UISetting{
+		SwaggerUI: SwaggerUIConfig{
+			InitOAuth: &SwaggerUIOAuth{
+				ClientId:                          "client",
+				Realm:                             "realm",
+				AppName:                           "app",
+				ScopeSeparator:                    " ",
+				UsePkceWithAuthorizationCodeGrant: true,
+			},
+		},
+	})
+
+	req = httptest.NewRequest(echo.GET, "/doc/oauth2-redirect.html", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "window.opener.swaggerUIRedirectOauth2")
+
+	req = httptest.NewRequest(echo.GET, "/doc/", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `oauth2RedirectUrl: docUrl + "/oauth2-redirect.html"`)
+	assert.Contains(t, rec.Body.String(), `ui.initOAuth({"clientId":"client","realm":"realm","appName":"app","scopeSeparator":" ","usePkceWithAuthorizationCodeGrant":true})`)
+
+	r = New(echo.New(), "doc/", nil)
+	_, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+	assert.PanicsWithValue(t, "echoswagger: doc endpoint /doc/oauth2-redirect.html can't be added after spec is generated", func() {
+		r.AddSecurityOAuth2("OAuth2", "", OAuth2FlowImplicit, "http://localhost:8080/authorize", "", nil)
+	})
+	assert.NotContains(t, r.(*Root).spec.SecurityDefinitions, "OAuth2")
+}
@@ -1,10 +1,12 @@
 package echoswagger
 
 import (
+	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"net/http"
-	"net/url"
 	"reflect"
+	"strings"
 
 	"github.com/labstack/echo"
 )
@@ -13,32 +15,62 @@ const (
 	DefPrefix      = "#/definitions/"
 	SwaggerVersion = "2.0"
 	SpecName       = "swagger.json"
+	// OAuth2RedirectName is the name of the OAuth2 redirect page of Swagger UI,
+	// which is served under docPath once an oauth2 security is added.
+	OAuth2RedirectName = "oauth2-redirect.html"
+	// CoverageName is the name of documentation coverage report served under docPath.
+	CoverageName = "coverage.json"
+	// wildcardParam is the name of path parameter for "*" of routes.
+	wildcardParam = "filepath"
 )
 
 func (r *Root) specHandler(docPath string) echo.HandlerFunc {
+	return r.specViewHandler(docPath, connectPath(docPath, SpecName), r, r.rawSpec)
+}
+
+// specViewHandler serves spec returned by get at specPath under docPath,
+// host and basePath are resolved from the doc page which loads it,
+// then hooks of owner are applied.
+func (r *Root) specViewHandler(docPath, specPath string, owner *Root, get func(c echo.Context) (Swagger, error)) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		spec, err := r.GetSpec(c, docPath)
+		spec, err := get(c)
 		if err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
-		var basePath string
-		if uri, err := url.ParseRequestURI(c.Request().Referer()); err == nil {
-			basePath = trimSuffixSlash(uri.Path, docPath)
-			spec.Host = uri.Host
-		} else {
-			basePath = trimSuffixSlash(c.Request().URL.Path, connectPath(docPath, SpecName))
-			spec.Host = c.Request().Host
+		r.resolveHost(c, docPath, specPath, &spec)
+		return r.serveSpec(c, specCacheKey(specPath, spec), func() (interface{}, error) {
+			return owner.applyHooks(spec)
+		})
+	}
+}
+
+// trimDocPath trims docPath, or a UI sub-path of it, from the end of path.
+func (r *Root) trimDocPath(path, docPath string) string {
+	for sub := range r.ui.SubPaths {
+		subPath := connectPath(docPath, strings.Trim(sub, "/"))
+		if strings.HasSuffix(removeTrailingSlash(connectPath(path)), subPath) {
+			return trimSuffixSlash(path, subPath)
 		}
-		spec.BasePath = basePath
-		return c.JSON(http.StatusOK, spec)
 	}
+	return trimSuffixSlash(path, docPath)
 }
 
-// Generate swagger spec data, without host & basePath info
+// Generate swagger spec data with hooks added by OnSpec applied,
+// without host & basePath info
 func (r *Root) GetSpec(c echo.Context, docPath string) (Swagger, error) {
+	spec, err := r.rawSpec(c)
+	if err != nil {
+		return spec, err
+	}
+	return r.applyHooks(spec)
+}
+
+// rawSpec generates spec once, hooks added by OnSpec aren't applied.
+func (r *Root) rawSpec(c echo.Context) (Swagger, error) {
 	r.once.Do(func() {
 		r.err = r.genSpec(c)
 		r.cleanUp()
+		r.cache.reset()
 	})
 	if r.err != nil {
 		return Swagger{}, r.err
@@ -46,15 +78,60 @@ func (r *Root) GetSpec(c echo.Context, docPath string) (Swagger, error) {
 	return *r.spec, nil
 }
 
+// applyHooks applies hooks added by OnSpec to a deep copy of spec in order,
+// so spec shared by requests isn't changed.
+func (r *Root) applyHooks(spec Swagger) (Swagger, error) {
+	if len(r.hooks) == 0 {
+		return spec, nil
+	}
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return Swagger{}, err
+	}
+	var s Swagger
+	if err := json.Unmarshal(b, &s); err != nil {
+		return Swagger{}, err
+	}
+	for _, h := range r.hooks {
+		if err := h(&s); err != nil {
+			return Swagger{}, err
+		}
+	}
+	return s, nil
+}
+
 func (r *Root) genSpec(c echo.Context) error {
 	r.spec.Swagger = SwaggerVersion
-	r.spec.Paths = make(map[string]interface{})
+	// Paths set by SetRaw are kept.
+	if r.spec.Paths == nil {
+		r.spec.Paths = make(map[string]interface{})
+	}
+	if r.spec.Definitions == nil {
+		r.spec.Definitions = make(map[string]*JSONSchema)
+	}
+	if r.spec.SecurityDefinitions == nil {
+		r.spec.SecurityDefinitions = make(map[string]*SecurityDefinition)
+	}
+	r.audiences = make(map[*Operation][]string)
+	r.generated = make(map[*Operation]bool)
 
+	hidden := false
 	for i := range r.groups {
 		group := &r.groups[i]
+		if group.hidden {
+			hidden = true
+			continue
+		}
 		r.spec.Tags = append(r.spec.Tags, &group.tag)
 		for j := range group.apis {
 			a := &group.apis[j]
+			if a.hidden {
+				hidden = true
+				continue
+			}
+			if len(a.audiences) == 0 {
+				a.audiences = group.audiences
+			}
 			if err := a.operation.addSecurity(r.spec.SecurityDefinitions, group.security); err != nil {
 				return err
 			}
@@ -65,15 +142,38 @@ func (r *Root) genSpec(c echo.Context) error {
 	}
 
 	for i := range r.apis {
+		if r.apis[i].hidden {
+			hidden = true
+			continue
+		}
 		if err := r.transfer(&r.apis[i]); err != nil {
 			return err
 		}
 	}
+	r.discover()
 
+	// Definitions not generated, e.g. set by SetRaw, are always kept.
+	kept := make(map[string]*JSONSchema)
+	for k, v := range r.spec.Definitions {
+		if _, ok := (*r.defs)[k]; !ok {
+			kept[k] = v
+		}
+	}
+	r.generatedDefs = make(map[string]bool)
 	for k, v := range *r.defs {
 		r.spec.Definitions[k] = v.Schema
+		r.generatedDefs[k] = true
 	}
-	return nil
+	if hidden {
+		// Remove generated definitions only used by hidden apis.
+		used := referencedDefinitions([]interface{}{r.spec.Paths, r.spec.Responses, r.spec.Parameters, kept}, r.spec.Definitions)
+		for k := range *r.defs {
+			if _, ok := used[k]; !ok {
+				delete(r.spec.Definitions, k)
+			}
+		}
+	}
+	return r.mergeFragments()
 }
 
 func (r *Root) transfer(a *api) error {
@@ -82,12 +182,26 @@ func (r *Root) transfer(a *api) error {
 	}
 
 	path := toSwaggerPath(a.route.Path)
+	if a.wildcard {
+		path = toSwaggerWildcardPath(a.route.Path)
+	}
+	if len(a.audiences) > 0 {
+		r.audiences[&a.operation] = a.audiences
+	}
+	r.generated[&a.operation] = true
+	a.operation.addMultipartConsumes(r.spec.Consumes)
+	a.addIntrospection()
+	a.operation.addHandlerDoc(a.route.Name)
 	if len(a.operation.Responses) == 0 {
 		a.operation.Responses["default"] = &Response{
 			Description: "successful operation",
 		}
 	}
 
+	if !isValidMethod(a.route.Method) {
+		return errors.New("echoswagger: method " + a.route.Method + " of " + a.route.Path +
+			" can't be described by swagger, hide it by Hidden()")
+	}
 	if p, ok := r.spec.Paths[path]; ok {
 		p.(*Path).oprationAssign(a.route.Method, &a.operation)
 	} else {
@@ -118,23 +232,26 @@ func (p *Path) oprationAssign(method string, operation *Operation) {
 }
 
 func (r *Root) cleanUp() {
+	releaseRoutes(r.echo)
 	r.echo = nil
 	r.groups = nil
 	r.apis = nil
 	r.defs = nil
+	r.fragments = nil
 }
 
 // addDefinition adds definition specification and returns
 // key of RawDefineDic
-func (r *RawDefineDic) addDefinition(v reflect.Value) string {
+func (r *RawDefineDic) addDefinition(v reflect.Value, conf *config) (string, error) {
 	exist, key := r.getKey(v)
 	if exist {
-		return key
+		return key, nil
 	}
 
 	schema := &JSONSchema{
-		Type:       "object",
-		Properties: make(map[string]*JSONSchema),
+		Type:        "object",
+		Properties:  make(map[string]*JSONSchema),
+		Description: typeDoc(v.Type()),
 	}
 
 	(*r)[key] = RawDefine{
@@ -142,7 +259,9 @@ func (r *RawDefineDic) addDefinition(v reflect.Value) string {
 		Schema: schema,
 	}
 
-	r.handleStruct(v, schema)
+	if err := r.handleStruct(v, schema, conf); err != nil {
+		return "", err
+	}
 
 	if schema.XML == nil {
 		schema.XML = &XMLSchema{}
@@ -150,15 +269,15 @@ func (r *RawDefineDic) addDefinition(v reflect.Value) string {
 	if schema.XML.Name == "" {
 		schema.XML.Name = v.Type().Name()
 	}
-	return key
+	return key, nil
 }
 
 // handleStruct handles fields of a struct
-func (r *RawDefineDic) handleStruct(v reflect.Value, schema *JSONSchema) {
+func (r *RawDefineDic) handleStruct(v reflect.Value, schema *JSONSchema, conf *config) error {
 	for i := 0; i < v.NumField(); i++ {
 		f := v.Type().Field(i)
 		name, hasTag := getFieldName(f, ParamInBody)
-		if name == "-" {
+		if name == "-" || isHiddenField(f) {
 			continue
 		}
 		if f.Type == reflect.TypeOf(xml.Name{}) {
@@ -166,16 +285,80 @@ func (r *RawDefineDic) handleStruct(v reflect.Value, schema *JSONSchema) {
 			continue
 		}
 		if f.Type.Kind() == reflect.Struct && f.Anonymous && !hasTag {
-			r.handleStruct(v.Field(i), schema)
+			if err := r.handleStruct(v.Field(i), schema, conf); err != nil {
+				return err
+			}
 			continue
 		}
-		sp := r.genSchema(v.Field(i))
+		sp, err := r.genSchema(v.Field(i), conf)
+		if err != nil {
+			return err
+		}
 		sp.handleXMLTags(f)
 		if sp.XML != nil {
 			sp.handleChildXMLTags(sp.XML.Name, r)
 		}
 		schema.Properties[name] = sp
 
-		schema.handleSwaggerTags(f, name)
+		if err := schema.handleSwaggerTags(v.Type(), f, name, conf); err != nil {
+			return err
+		}
+		if sp.Description == "" && sp.Ref == "" {
+			sp.Description = fieldDoc(v.Type(), f)
+		}
+	}
+	return nil
+}
+
+// referencedDefinitions returns definitions in defs which are referred by v,
+// directly or through other definitions.
+func referencedDefinitions(v interface{}, defs map[string]*JSONSchema) map[string]*JSONSchema {
+	r := make(map[string]*JSONSchema)
+	queue := collectRefs(v)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		d, ok := defs[name]
+		if !ok {
+			continue
+		}
+		if _, ok := r[name]; ok {
+			continue
+		}
+		r[name] = d
+		queue = append(queue, collectRefs(d)...)
+	}
+	return r
+}
+
+// collectRefs returns names of definitions referred by v.
+func collectRefs(v interface{}) []string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var raw interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil
+	}
+	var refs []string
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			for k, e := range t {
+				if ref, ok := e.(string); ok && k == "$ref" && strings.HasPrefix(ref, DefPrefix) {
+					refs = append(refs, strings.TrimPrefix(ref, DefPrefix))
+					continue
+				}
+				walk(e)
+			}
+		case []interface{}:
+			for _, e := range t {
+				walk(e)
+			}
+		}
 	}
+	walk(raw)
+	return refs
 }
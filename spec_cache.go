@@ -0,0 +1,189 @@
+package echoswagger
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+// Encoder compresses serialized spec for a content encoding.
+type Encoder func(b []byte) ([]byte, error)
+
+// GzipEncoder compresses spec with gzip.
+func GzipEncoder(b []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w, err := gzip.NewWriterLevel(buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// maxCachedSpecs limits entries of specCache, as host and basePath of specs
+// come from requests.
+const maxCachedSpecs = 64
+
+// specCache caches serialized specs and their compressed forms.
+type specCache struct {
+	sync.Mutex
+	entries  map[string]*cachedSpec
+	modified time.Time
+}
+
+type cachedSpec struct {
+	body    []byte
+	etag    string
+	encoded map[string][]byte
+}
+
+// reset invalidates all cached specs, it's called when spec is regenerated.
+func (sc *specCache) reset() {
+	sc.Lock()
+	defer sc.Unlock()
+	sc.entries = nil
+	sc.modified = time.Now().UTC().Truncate(time.Second)
+}
+
+// resetCache invalidates cached specs of r, and the ones cached by roots
+// serving the spec of r.
+func (r *Root) resetCache() {
+	r.cache.reset()
+	for _, h := range r.hosts {
+		h.cache.reset()
+	}
+}
+
+// load returns cached spec with key, and caches spec returned by get
+// if it's not cached.
+func (sc *specCache) load(key string, get func() (interface{}, error)) (*cachedSpec, time.Time, error) {
+	sc.Lock()
+	defer sc.Unlock()
+	if e, ok := sc.entries[key]; ok {
+		return e, sc.modified, nil
+	}
+	spec, err := get()
+	if err != nil {
+		return nil, sc.modified, err
+	}
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, sc.modified, err
+	}
+	sum := sha256.Sum256(b)
+	e := &cachedSpec{
+		body:    b,
+		etag:    hex.EncodeToString(sum[:16]),
+		encoded: make(map[string][]byte),
+	}
+	if sc.entries == nil || len(sc.entries) >= maxCachedSpecs {
+		sc.entries = make(map[string]*cachedSpec)
+	}
+	sc.entries[key] = e
+	if sc.modified.IsZero() {
+		sc.modified = time.Now().UTC().Truncate(time.Second)
+	}
+	return e, sc.modified, nil
+}
+
+// encode returns body of spec compressed by encoder for encoding.
+func (sc *specCache) encode(e *cachedSpec, encoding string, encoder Encoder) ([]byte, error) {
+	sc.Lock()
+	defer sc.Unlock()
+	if b, ok := e.encoded[encoding]; ok {
+		return b, nil
+	}
+	b, err := encoder(e.body)
+	if err != nil {
+		return nil, err
+	}
+	e.encoded[encoding] = b
+	return b, nil
+}
+
+// specCacheKey returns key of spec served at specPath in specCache.
+func specCacheKey(specPath string, spec Swagger) string {
+	return strings.Join([]string{specPath, spec.Host, spec.BasePath, strings.Join(spec.Schemes, ",")}, "\x00")
+}
+
+// serveSpec writes spec returned by get and cached with key, supporting
+// conditional requests and content encodings set by UISetting.
+func (r *Root) serveSpec(c echo.Context, key string, get func() (interface{}, error)) error {
+	e, modified, err := r.cache.load(key, get)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, err.Error())
+	}
+
+	body, etag := e.body, e.etag
+	header := c.Response().Header()
+	if len(r.ui.SpecEncoders) > 0 {
+		header.Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+		if encoding, encoder := r.ui.specEncoder(c.Request().Header.Get(echo.HeaderAcceptEncoding)); encoder != nil {
+			b, err := r.cache.encode(e, encoding, encoder)
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			body, etag = b, etag+"-"+encoding
+			header.Set(echo.HeaderContentEncoding, encoding)
+		}
+	}
+	etag = `"` + etag + `"`
+	header.Set("ETag", etag)
+	header.Set(echo.HeaderLastModified, modified.Format(http.TimeFormat))
+
+	req := c.Request()
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		if matchETag(inm, etag) {
+			header.Del(echo.HeaderContentEncoding)
+			return c.NoContent(http.StatusNotModified)
+		}
+	} else if ims, err := http.ParseTime(req.Header.Get(echo.HeaderIfModifiedSince)); err == nil && !modified.After(ims) {
+		header.Del(echo.HeaderContentEncoding)
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, body)
+}
+
+// specEncoder returns the first encoding accepted by acceptEncoding
+// which has an encoder.
+func (u UISetting) specEncoder(acceptEncoding string) (string, Encoder) {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		encoding := strings.ToLower(strings.TrimSpace(fields[0]))
+		refused := false
+		for _, param := range fields[1:] {
+			param = strings.Replace(param, " ", "", -1)
+			if param == "q=0" || strings.HasPrefix(param, "q=0.") && strings.Trim(param[4:], "0") == "" {
+				refused = true
+			}
+		}
+		if encoder, ok := u.SpecEncoders[encoding]; ok && !refused {
+			return encoding, encoder
+		}
+	}
+	return "", nil
+}
+
+// matchETag reports whether If-None-Match header inm matches etag.
+func matchETag(inm, etag string) bool {
+	for _, t := range strings.Split(inm, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package echoswagger
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecCache(t *testing.T) {
+	e := echo.New()
+	r := New(e, "doc/", nil)
+	r.GET("/users", func(c echo.Context) error { return nil })
+	r.SetUI(UISetting{SpecEncoders: map[string]Encoder{"gzip": GzipEncoder}})
+
+	get := func(header map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get(nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.Bytes()
+	etag := rec.Header().Get("ETag")
+	modified := rec.Header().Get(echo.HeaderLastModified)
+	assert.NotEmpty(t, etag)
+	assert.NotEmpty(t, modified)
+	assert.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+	assert.Equal(t, echo.HeaderAcceptEncoding, rec.Header().Get(echo.HeaderVary))
+	assert.Contains(t, string(body), `"/users"`)
+
+	t.Run("Cached", func(t *testing.T) {
+		rec := get(nil)
+		assert.Equal(t, body, rec.Body.Bytes())
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+	})
+
+	t.Run("IfNoneMatch", func(t *testing.T) {
+		rec := get(map[string]string{"If-None-Match": `"other", ` + etag})
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+
+		rec = get(map[string]string{"If-None-Match": `"other"`})
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("IfModifiedSince", func(t *testing.T) {
+		rec := get(map[string]string{echo.HeaderIfModifiedSince: modified})
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+
+		rec = get(map[string]string{echo.HeaderIfModifiedSince: time.Unix(0, 0).UTC().Format(http.TimeFormat)})
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Gzip", func(t *testing.T) {
+		rec := get(map[string]string{echo.HeaderAcceptEncoding: "br, gzip;q=0.8"})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "gzip", rec.Header().Get(echo.HeaderContentEncoding))
+		gzEtag := rec.Header().Get("ETag")
+		assert.NotEqual(t, etag, gzEtag)
+		zr, err := gzip.NewReader(bytes.NewReader(rec.Body.Bytes()))
+		if assert.NoError(t, err) {
+			b, err := ioutil.ReadAll(zr)
+			assert.NoError(t, err)
+			assert.Equal(t, body, b)
+		}
+
+		rec = get(map[string]string{echo.HeaderAcceptEncoding: "gzip", "If-None-Match": gzEtag})
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+
+		rec = get(map[string]string{echo.HeaderAcceptEncoding: "gzip;q=0"})
+		assert.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+		assert.Equal(t, body, rec.Body.Bytes())
+	})
+
+	t.Run("Invalidate", func(t *testing.T) {
+		spec := *r.GetRaw()
+		spec.Info = &Info{Title: "Changed"}
+		r.SetRaw(&spec)
+		rec := get(nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+		assert.Contains(t, rec.Body.String(), `"Changed"`)
+	})
+}
+
+func TestSpecCacheOfAddedSpec(t *testing.T) {
+	e := echo.New()
+	h := func(c echo.Context) error { return nil }
+	v1 := New(e, "/doc", &Info{Title: "v1", Version: "1.0"})
+	v2 := New(e, "/v2/doc", &Info{Title: "v2", Version: "2.0"})
+	v2.GET("/v2/users", h)
+	v1.AddSpec("v2", v2)
+
+	get := func() Swagger {
+		req := httptest.NewRequest(echo.GET, "/doc/v2/swagger.json", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var spec Swagger
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+		return spec
+	}
+
+	assert.Equal(t, "2.0", get().Info.Version)
+	raw := *v2.GetRaw()
+	info := *raw.Info
+	info.Version = "2.1"
+	raw.Info = &info
+	v2.SetRaw(&raw)
+	assert.Equal(t, "2.1", get().Info.Version)
+
+	v2.OnSpec(func(s *Swagger) error {
+		s.Info.Version = "2.2"
+		return nil
+	})
+	assert.Equal(t, "2.2", get().Info.Version)
+}
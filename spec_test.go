@@ -2,8 +2,12 @@ package echoswagger
 
 import (
 	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -200,6 +204,191 @@ func TestReferer(t *testing.T) {
 	}
 }
 
+func TestAddSpec(t *testing.T) {
+	e := echo.New()
+	h := func(c echo.Context) error { return nil }
+	v1 := New(e, "/doc", &Info{Title: "v1", Version: "1.0"})
+	v1.GET("/v1/users", h)
+	v2 := New(e, "/v2/doc", &Info{Title: "v2", Version: "2.0"})
+	v2.GET("/v2/users", h)
+	v1.AddSpec("v2", v2).AddSpec("nop", NewNop(e))
+
+	req := httptest.NewRequest(echo.GET, "/doc/v2/swagger.json", nil)
+	req.Header.Add("referer", "http://localhost:1323/api/doc/")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var spec Swagger
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+	assert.Equal(t, "2.0", spec.Info.Version)
+	assert.Equal(t, "/api", spec.BasePath)
+	assert.Contains(t, spec.Paths, "/v2/users")
+	assert.NotContains(t, spec.Paths, "/v1/users")
+
+	req = httptest.NewRequest(echo.GET, "/doc", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `var urls = [{"name":"v1","path":"swagger.json"},{"name":"v2","path":"v2/swagger.json"}]`)
+	assert.Contains(t, rec.Body.String(), `var specStr = ""`)
+
+	assert.Panics(t, func() {
+		v1.AddSpec("v2", v2)
+	})
+	assert.Panics(t, func() {
+		v1.AddSpec("a/b", v2)
+	})
+	assert.Panics(t, func() {
+		v1.AddSpec(SpecName, v2)
+	})
+}
+
+func TestHidden(t *testing.T) {
+	type Debug struct {
+		Trace string
+	}
+	type User struct {
+		Name  string
+		Debug Debug  `swagger:"-"`
+		Token string `json:"token" swagger:"hidden,desc(Internal token)"`
+		Hook  func() `swagger:"-"`
+	}
+	type Query struct {
+		Q     string `query:"q"`
+		Trace bool   `query:"trace" swagger:"hidden"`
+		Hook  func() `swagger:"-"`
+	}
+	type Secret struct {
+		Key string
+	}
+	h := func(c echo.Context) error { return nil }
+
+	r := New(echo.New(), "doc/", nil)
+	r.POST("/users", h).AddParamBody(User{}, "body", "", true).AddParamQueryNested(Query{})
+	r.GET("/debug", h).AddResponse(http.StatusOK, "", Secret{}, nil).Hidden()
+	g := r.Group("Internal", "/internal").Hidden()
+	g.GET("/state", h)
+	r.GetRaw().Definitions["Error"] = &JSONSchema{Type: "object"}
+
+	spec, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+	assert.Len(t, spec.Paths, 1)
+	assert.Contains(t, spec.Paths, "/users")
+	assert.Len(t, spec.Tags, 0)
+	// Definitions not generated are kept.
+	assert.Len(t, spec.Definitions, 2)
+	assert.Contains(t, spec.Definitions, "Error")
+	if assert.Contains(t, spec.Definitions, "User") {
+		assert.Len(t, spec.Definitions["User"].Properties, 1)
+		assert.Contains(t, spec.Definitions["User"].Properties, "Name")
+	}
+	params := spec.Paths["/users"].(*Path).Post.Parameters
+	if assert.Len(t, params, 2) {
+		assert.Equal(t, "q", params[1].Name)
+	}
+
+	// Generated definitions referenced by kept ones are kept.
+	r = New(echo.New(), "doc/", nil)
+	r.GET("/debug", h).AddResponse(http.StatusOK, "", Secret{}, nil).Hidden()
+	r.GetRaw().Definitions["Envelope"] = &JSONSchema{
+		Type:       "object",
+		Properties: map[string]*JSONSchema{"secret": {Ref: DefPrefix + "Secret"}},
+	}
+	spec, err = r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+	assert.Len(t, spec.Definitions, 2)
+	assert.Contains(t, spec.Definitions, "Secret")
+}
+
+func TestRoutingMethods(t *testing.T) {
+	h := func(c echo.Context) error { return nil }
+
+	t.Run("AnyMatch", func(t *testing.T) {
+		r := New(echo.New(), "doc/", nil)
+		r.Any("/any", h).SetSummary("any").SetOperationId("any")
+		r.Match([]string{echo.GET, echo.POST}, "/match", h).SetDescription("match")
+		r.Group("G", "/g").Any("/any", h)
+		r.CONNECT("/connect", h).Hidden()
+		r.TRACE("/trace", h).Hidden()
+
+		spec, err := r.(*Root).GetSpec(nil, "/doc")
+		assert.NoError(t, err)
+		any := spec.Paths["/any"].(*Path)
+		for _, o := range []*Operation{any.Get, any.Post, any.Put, any.Delete, any.Options, any.Head, any.Patch} {
+			if assert.NotNil(t, o) {
+				assert.Equal(t, "any", o.Summary)
+			}
+		}
+		assert.Equal(t, "any_get", any.Get.OperationID)
+		match := spec.Paths["/match"].(*Path)
+		assert.Equal(t, "match", match.Get.Description)
+		assert.Equal(t, "match", match.Post.Description)
+		assert.Nil(t, match.Put)
+		assert.Equal(t, []string{"G"}, spec.Paths["/g/any"].(*Path).Patch.Tags)
+		assert.NotContains(t, spec.Paths, "/connect")
+		assert.NotContains(t, spec.Paths, "/trace")
+	})
+
+	t.Run("StaticFile", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "echoswagger")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "swagger.json"), []byte("{}"), 0644))
+
+		e := echo.New()
+		r := New(e, "doc/", nil)
+		r.Static("/static", dir).SetOperationId("static")
+		r.Group("G", "/g").File("/spec", filepath.Join(dir, "swagger.json"))
+		r.GET("/files/*", testHandler)
+
+		req := httptest.NewRequest(echo.GET, "/static/swagger.json", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		req = httptest.NewRequest(echo.GET, "/static/../go.mod", nil)
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		spec, err := r.(*Root).GetSpec(nil, "/doc")
+		assert.NoError(t, err)
+		if assert.Contains(t, spec.Paths, "/static") {
+			assert.Equal(t, "static_get_1", spec.Paths["/static"].(*Path).Get.OperationID)
+		}
+		if assert.Contains(t, spec.Paths, "/static/{filepath}") {
+			o := spec.Paths["/static/{filepath}"].(*Path).Get
+			assert.Equal(t, "static_get_2", o.OperationID)
+			assert.Equal(t, []string{"application/octet-stream"}, o.Produces)
+			assert.EqualValues(t, "file", o.Responses["200"].Schema.Type)
+			if assert.Len(t, o.Parameters, 1) {
+				assert.Equal(t, "filepath", o.Parameters[0].Name)
+				assert.Equal(t, "path", o.Parameters[0].In)
+			}
+		}
+		// Wildcards of other routes are kept as they are.
+		if assert.Contains(t, spec.Paths, "/files/*") {
+			assert.Empty(t, spec.Paths["/files/*"].(*Path).Get.Parameters)
+		}
+		if assert.Contains(t, spec.Paths, "/g/spec") {
+			o := spec.Paths["/g/spec"].(*Path).Get
+			assert.Equal(t, []string{"G"}, o.Tags)
+			assert.EqualValues(t, "file", o.Responses["200"].Schema.Type)
+		}
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		r := New(echo.New(), "doc/", nil)
+		r.Match([]string{echo.GET, "PROPFIND"}, "/match", h)
+		_, err := r.(*Root).GetSpec(nil, "/doc")
+		assert.EqualError(t, err, "echoswagger: method PROPFIND of /match can't be described by swagger, hide it by Hidden()")
+
+		r = New(echo.New(), "doc/", nil)
+		r.TRACE("/trace", h)
+		_, err = r.(*Root).GetSpec(nil, "/doc")
+		assert.Error(t, err)
+	})
+}
+
 func TestAddDefinition(t *testing.T) {
 	type DA struct {
 		Name string
@@ -232,3 +421,68 @@ func TestAddDefinition(t *testing.T) {
 		assert.Len(t, r.(*Root).spec.Definitions, 2)
 	}
 }
+
+func TestOnSpec(t *testing.T) {
+	e := echo.New()
+	r := New(e, "doc/", &Info{Title: "Pets", Version: "1.0.0"}).
+		SetHost(HostSetting{Strategy: HostFixed, Host: "api.example.com", BasePath: "/v1"})
+	r.GET("/pets", testHandler).SetOperationId("listPets").AddResponse(http.StatusOK, "pets", nil, nil)
+	r.Group("Internal", "/internal").GET("/stats", testHandler)
+
+	var calls []string
+	r.OnSpec(func(s *Swagger) error {
+		calls = append(calls, "errors")
+		for _, v := range s.Paths {
+			p := v.(*Path)
+			for _, o := range []*Operation{p.Get, p.Post, p.Put, p.Delete} {
+				if o != nil {
+					o.Responses["500"] = &Response{Description: "internal error"}
+				}
+			}
+		}
+		return nil
+	}).OnSpec(func(s *Swagger) error {
+		calls = append(calls, "internal")
+		delete(s.Paths, "/internal/stats")
+		s.Tags = nil
+		s.Info.Description = "Served at " + s.Host + s.BasePath
+		return nil
+	})
+
+	spec, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"errors", "internal"}, calls)
+	assert.Equal(t, "Served at ", spec.Info.Description)
+	assert.Len(t, spec.Paths, 1)
+	assert.Empty(t, spec.Tags)
+	assert.Equal(t, "internal error", spec.Paths["/pets"].(*Path).Get.Responses["500"].Description)
+
+	raw := r.GetRaw()
+	assert.Len(t, raw.Paths, 2)
+	assert.Len(t, raw.Tags, 1)
+	assert.NotContains(t, raw.Paths["/pets"].(*Path).Get.Responses, "500")
+
+	calls = nil
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var s Swagger
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &s))
+		assert.Equal(t, "Served at api.example.com/v1", s.Info.Description)
+		assert.Len(t, s.Paths, 1)
+	}
+	assert.Equal(t, []string{"errors", "internal"}, calls, "hooks run once for cached spec")
+
+	r.OnSpec(func(s *Swagger) error {
+		return errors.New("invalid spec")
+	})
+	_, err = r.(*Root).GetSpec(nil, "/doc")
+	assert.EqualError(t, err, "invalid spec")
+	req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "invalid spec", rec.Body.String())
+}
@@ -1,7 +1,11 @@
 package echoswagger
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -19,19 +23,380 @@ func getTag(field reflect.StructField, tagName string, index int) (bool, string)
 	return true, strings.TrimSpace(s[index])
 }
 
-func getSwaggerTags(field reflect.StructField) map[string]string {
-	t := field.Tag.Get("swagger")
+// swaggerTags holds the `swagger` tag of a struct field.
+type swaggerTags struct {
+	owner  reflect.Type
+	field  reflect.StructField
+	values map[string]string
+}
+
+func getSwaggerTags(owner reflect.Type, field reflect.StructField, conf *config) (swaggerTags, error) {
+	t := swaggerTags{owner: owner, field: field}
+	values, err := parseSwaggerTag(field.Tag.Get("swagger"))
+	if err != nil {
+		return t, fmt.Errorf("echoswagger: invalid swagger tag of field %s: %v", t.fieldName(), err)
+	}
+	t.values = values
+	if conf != nil && conf.validateTag != "" {
+		t.addValidateTags(conf.validateTag)
+	}
+	return t, nil
+}
+
+// isHiddenField reports whether field f is hidden from spec
+// by tag `swagger:"-"` or `swagger:"hidden"`.
+func isHiddenField(f reflect.StructField) bool {
+	tag := f.Tag.Get("swagger")
+	if tag == "-" {
+		return true
+	}
+	values, err := parseSwaggerTag(tag)
+	_, ok := values["hidden"]
+	return err == nil && ok
+}
+
+// parseSwaggerTag splits a `swagger` tag into keys and raw values.
+//
+// The tag is a comma separated list of `key` or `key(value)`, and the last one
+// of duplicate keys wins. A value ends at the last ")" before the next "," which
+// is outside parentheses, so commas within balanced parentheses and unbalanced
+// parentheses like "desc(smile :))" are both kept in the value.
+// "\" makes the next character literal, it's removed before "," "|" and "'",
+// and kept before other characters, so regular expressions are written as is.
+// A value, or an element of a "|" separated value, starting with "'" and ending
+// with "'" is quoted: a doubled "'" stands for itself and nothing is escaped in it.
+func parseSwaggerTag(tag string) (map[string]string, error) {
 	r := make(map[string]string)
-	for _, v := range strings.Split(t, ",") {
-		leftIndex := strings.Index(v, "(")
-		rightIndex := strings.LastIndex(v, ")")
-		if leftIndex > 0 && rightIndex > leftIndex {
-			r[v[:leftIndex]] = v[leftIndex+1 : rightIndex]
+	for i := 0; i < len(tag); i++ {
+		start := i
+		for i < len(tag) && tag[i] != ',' && tag[i] != '(' {
+			if tag[i] == ')' {
+				return nil, fmt.Errorf("unexpected ')' at offset %d", i)
+			}
+			i++
+		}
+		key := strings.TrimSpace(tag[start:i])
+		var value string
+		if i < len(tag) && tag[i] == '(' {
+			if key == "" {
+				return nil, fmt.Errorf("missing name before '(' at offset %d", i)
+			}
+			end, next, err := scanTagValue(tag, i+1)
+			if err != nil {
+				return nil, err
+			}
+			value = tag[i+1 : end]
+			if rest := strings.TrimSpace(tag[end+1 : next]); rest != "" {
+				return nil, fmt.Errorf("unexpected %q at offset %d", rest, strings.Index(tag[end+1:], rest)+end+1)
+			}
+			i = next
+		}
+		if key == "" {
+			continue
+		}
+		r[key] = value
+	}
+	return r, nil
+}
+
+// scanTagValue returns the offset of the ')' ending the value starting at
+// offset i, and the offset of the "," ending the item of the tag.
+// If parentheses of the tag are unbalanced, the item ends at the next ",".
+func scanTagValue(tag string, i int) (end, next int, err error) {
+	end, next, balanced, err := scanTagItem(tag, i, true)
+	if err == nil && !balanced {
+		end, next, _, err = scanTagItem(tag, i, false)
+	}
+	return end, next, err
+}
+
+// scanTagItem scans the item of the tag from the value starting at offset i,
+// commas within parentheses don't end the item if nested is true.
+func scanTagItem(tag string, i int, nested bool) (end, next int, balanced bool, err error) {
+	depth, end := 1, -1
+	elemStart := true
+	for next = i; next < len(tag); next++ {
+		c := tag[next]
+		if elemStart && c == ' ' {
+			continue
+		}
+		if elemStart && c == '\'' {
+			if q := quoteEnd(tag, next); q > 0 {
+				next = q
+				elemStart = false
+				continue
+			}
+		}
+		elemStart = false
+		if c == ',' && (!nested || depth <= 0) {
+			break
+		}
+		switch c {
+		case '\\':
+			if next+1 >= len(tag) {
+				return 0, 0, false, fmt.Errorf("trailing '\\' at offset %d", next)
+			}
+			next++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			end = next
+		case '|':
+			elemStart = true
+		}
+	}
+	if end < 0 {
+		return 0, 0, false, errors.New("missing ')'")
+	}
+	return end, next, depth <= 0, nil
+}
+
+// quoteEnd returns the offset of the "'" closing the quote starting at offset i
+// of s, or -1 if it's not a quoted element, which must be followed by "|" or ")".
+func quoteEnd(s string, i int) int {
+	for j := i + 1; j < len(s); j++ {
+		if s[j] != '\'' {
+			continue
+		}
+		if j+1 < len(s) && s[j+1] == '\'' {
+			j++
+			continue
+		}
+		k := j + 1
+		for k < len(s) && s[k] == ' ' {
+			k++
+		}
+		if k < len(s) && s[k] != '|' && s[k] != ')' {
+			return -1
+		}
+		return j
+	}
+	return -1
+}
+
+// unquoteTagValue returns the content of a quoted value, or unescapes and
+// trims an unquoted one.
+func unquoteTagValue(v string) string {
+	t := strings.TrimSpace(v)
+	if isQuotedTagValue(t) {
+		return strings.Replace(t[1:len(t)-1], "''", "'", -1)
+	}
+	var b strings.Builder
+	for i := 0; i < len(t); i++ {
+		if t[i] == '\\' && i+1 < len(t) {
+			if strings.IndexByte(`,|'`, t[i+1]) < 0 {
+				b.WriteByte(t[i])
+			}
+			i++
+		}
+		b.WriteByte(t[i])
+	}
+	return b.String()
+}
+
+func isQuotedTagValue(t string) bool {
+	return len(t) >= 2 && t[0] == '\'' && quoteEnd(t, 0) == len(t)-1
+}
+
+// splitTagValue splits v by "|" which is neither escaped nor quoted, and unquotes the elements.
+func splitTagValue(v string) []string {
+	var r []string
+	start, elemStart := 0, true
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case elemStart && c == ' ':
+			continue
+		case elemStart && c == '\'':
+			if q := quoteEnd(v, i); q > 0 {
+				i = q
+			}
+		case c == '\\':
+			i++
+		case c == '|':
+			r = append(r, unquoteTagValue(v[start:i]))
+			start, elemStart = i+1, true
+			continue
+		}
+		elemStart = false
+	}
+	return append(r, unquoteTagValue(v[start:]))
+}
+
+func (t swaggerTags) fieldName() string {
+	if t.owner != nil && t.owner.Name() != "" {
+		return t.owner.Name() + "." + t.field.Name
+	}
+	return t.field.Name
+}
+
+func (t swaggerTags) has(key string) bool {
+	_, ok := t.values[key]
+	return ok
+}
+
+func (t swaggerTags) get(key string) (string, bool) {
+	v, ok := t.values[key]
+	if !ok {
+		return "", false
+	}
+	return unquoteTagValue(v), true
+}
+
+func (t swaggerTags) errorf(key, format string, a ...interface{}) error {
+	return fmt.Errorf("echoswagger: invalid swagger tag %s(%s) of field %s: %s",
+		key, t.values[key], t.fieldName(), fmt.Sprintf(format, a...))
+}
+
+func (t swaggerTags) setString(key string, dst *string) {
+	if v, ok := t.get(key); ok {
+		*dst = v
+	}
+}
+
+func (t swaggerTags) setFlag(key string, dst *bool) {
+	if t.has(key) {
+		*dst = true
+	}
+}
+
+func (t swaggerTags) parseFloat(key string) (float64, bool, error) {
+	v, ok := t.get(key)
+	if !ok {
+		return 0, false, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, true, t.errorf(key, "not a number")
+	}
+	return f, true, nil
+}
+
+func (t swaggerTags) setInt(key string, dst **int) error {
+	v, ok := t.get(key)
+	if !ok {
+		return nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 {
+		return t.errorf(key, "not a non-negative integer")
+	}
+	*dst = &i
+	return nil
+}
+
+// setBound handles tag key (e.g. "min") and its exclusive form (e.g. "exclusiveMin").
+func (t swaggerTags) setBound(key, exclusiveKey string, dst **float64, exclusive *bool) error {
+	if t.has(key) && t.has(exclusiveKey) {
+		return t.errorf(exclusiveKey, "conflicts with tag %s", key)
+	}
+	if f, ok, err := t.parseFloat(key); err != nil {
+		return err
+	} else if ok {
+		*dst = &f
+	}
+	if f, ok, err := t.parseFloat(exclusiveKey); err != nil {
+		return err
+	} else if ok {
+		*dst = &f
+		*exclusive = true
+	}
+	return nil
+}
+
+func (t swaggerTags) setMultipleOf(dst *float64) error {
+	f, ok, err := t.parseFloat("multipleOf")
+	if err != nil {
+		return err
+	}
+	if ok && f <= 0 {
+		return t.errorf("multipleOf", "must be greater than 0")
+	}
+	if ok {
+		*dst = f
+	}
+	return nil
+}
+
+func (t swaggerTags) setPattern(dst *string) error {
+	// Patterns are kept as written unless they're quoted, "\" isn't removed.
+	v, ok := t.values["pattern"]
+	if !ok {
+		return nil
+	}
+	if v = strings.TrimSpace(v); isQuotedTagValue(v) {
+		v = unquoteTagValue(v)
+	}
+	if _, err := regexp.Compile(v); err != nil {
+		return t.errorf("pattern", "%v", err)
+	}
+	*dst = v
+	return nil
+}
+
+func (t swaggerTags) setCollectionFormat(dst *string) error {
+	v, ok := t.get("collectionFormat")
+	if !ok {
+		return nil
+	}
+	if !contains([]string{"csv", "ssv", "tsv", "pipes", "multi"}, v) {
+		return t.errorf("collectionFormat", "must be one of csv, ssv, tsv, pipes or multi")
+	}
+	*dst = v
+	return nil
+}
+
+// setValue converts value of tag key by field type, used by "default" and "example".
+func (t swaggerTags) setValue(key string, dst *interface{}) error {
+	v, ok := t.get(key)
+	if !ok {
+		return nil
+	}
+	cv, err := converter(t.field.Type)(v)
+	if err != nil {
+		return t.errorf(key, "not a valid %s value", t.field.Type)
+	}
+	*dst = cv
+	return nil
+}
+
+func (t swaggerTags) setEnum(dst *[]interface{}) error {
+	v, ok := t.values["enum"]
+	if !ok {
+		return nil
+	}
+	convert := converter(t.field.Type)
+	var es []interface{}
+	for _, s := range splitTagValue(v) {
+		cv, err := convert(s)
+		if err != nil {
+			return t.errorf("enum", "%q is not a valid %s value", s, t.field.Type)
+		}
+		es = append(es, cv)
+	}
+	*dst = es
+	return nil
+}
+
+// setExtensions adds tags which key starts with "x-" into dst.
+// Values which are valid JSON are decoded, others are kept as string.
+func (t swaggerTags) setExtensions(dst *map[string]interface{}) {
+	for k := range t.values {
+		if !strings.HasPrefix(k, "x-") {
+			continue
+		}
+		v, _ := t.get(k)
+		if *dst == nil {
+			*dst = make(map[string]interface{})
+		}
+		var jv interface{}
+		if err := json.Unmarshal([]byte(v), &jv); err == nil {
+			(*dst)[k] = jv
 		} else {
-			r[v] = ""
+			(*dst)[k] = v
 		}
 	}
-	return r
 }
 
 func getFieldName(f reflect.StructField, in ParamInType) (string, bool) {
@@ -51,212 +416,234 @@ func getFieldName(f reflect.StructField, in ParamInType) (string, bool) {
 	}
 }
 
-func (p *Parameter) handleSwaggerTags(field reflect.StructField, name string, in ParamInType) {
-	tags := getSwaggerTags(field)
-
-	if t, ok := tags["desc"]; ok {
-		p.Description = t
-	}
-	if t, ok := tags["min"]; ok {
-		if m, err := strconv.ParseFloat(t, 64); err == nil {
-			p.Minimum = &m
-		}
-	}
-	if t, ok := tags["max"]; ok {
-		if m, err := strconv.ParseFloat(t, 64); err == nil {
-			p.Maximum = &m
-		}
-	}
-	if t, ok := tags["minLen"]; ok {
-		if m, err := strconv.Atoi(t); err == nil {
-			p.MinLength = &m
-		}
-	}
-	if t, ok := tags["maxLen"]; ok {
-		if m, err := strconv.Atoi(t); err == nil {
-			p.MaxLength = &m
-		}
-	}
-	if _, ok := tags["allowEmpty"]; ok {
-		p.AllowEmptyValue = true
-	}
-	if _, ok := tags["required"]; ok || in == ParamInPath {
-		p.Required = true
+func (p *Parameter) handleSwaggerTags(owner reflect.Type, field reflect.StructField, name string, in ParamInType, conf *config) error {
+	tags, err := getSwaggerTags(owner, field, conf)
+	if err != nil {
+		return err
 	}
 
-	convert := converter(field.Type)
-	if t, ok := tags["enum"]; ok {
-		enums := strings.Split(t, "|")
-		var es []interface{}
-		for _, s := range enums {
-			v, err := convert(s)
-			if err != nil {
-				continue
-			}
-			es = append(es, v)
-		}
-		p.Enum = es
+	tags.setString("desc", &p.Description)
+	tags.setFlag("allowEmpty", &p.AllowEmptyValue)
+	tags.setFlag("uniqueItems", &p.UniqueItems)
+	if tags.has("required") || in == ParamInPath {
+		p.Required = true
 	}
-	if t, ok := tags["default"]; ok {
-		v, err := convert(t)
-		if err == nil {
-			p.Default = v
+	var format string
+	var example interface{}
+	tags.setString("format", &format)
+	tags.setExtensions(&p.Extensions)
+	if v, ok := tags.values["contentType"]; ok {
+		if in != ParamInFormData {
+			return tags.errorf("contentType", "only formData parameters have content types")
 		}
+		p.setContentType(splitTagValue(v))
+	}
+	err = firstError(
+		tags.setBound("min", "exclusiveMin", &p.Minimum, &p.ExclusiveMinimum),
+		tags.setBound("max", "exclusiveMax", &p.Maximum, &p.ExclusiveMaximum),
+		tags.setInt("minLen", &p.MinLength),
+		tags.setInt("maxLen", &p.MaxLength),
+		tags.setInt("minItems", &p.MinItems),
+		tags.setInt("maxItems", &p.MaxItems),
+		tags.setMultipleOf(&p.MultipleOf),
+		tags.setPattern(&p.Pattern),
+		tags.setCollectionFormat(&p.CollectionFormat),
+		tags.setEnum(&p.Enum),
+		tags.setValue("default", &p.Default),
+		tags.setValue("example", &example),
+	)
+	if err != nil {
+		return err
 	}
 
 	// Move part of tags in Parameter to Items
 	if p.Type == "array" {
 		items := p.Items.latest()
 		items.Minimum = p.Minimum
+		items.ExclusiveMinimum = p.ExclusiveMinimum
 		items.Maximum = p.Maximum
+		items.ExclusiveMaximum = p.ExclusiveMaximum
 		items.MinLength = p.MinLength
 		items.MaxLength = p.MaxLength
+		items.MultipleOf = p.MultipleOf
+		items.Pattern = p.Pattern
 		items.Enum = p.Enum
 		items.Default = p.Default
+		if format != "" {
+			items.Format = format
+		}
 		p.Minimum = nil
+		p.ExclusiveMinimum = false
 		p.Maximum = nil
+		p.ExclusiveMaximum = false
 		p.MinLength = nil
 		p.MaxLength = nil
+		p.MultipleOf = 0
+		p.Pattern = ""
 		p.Enum = nil
 		p.Default = nil
-	}
-}
-
-func (s *JSONSchema) handleSwaggerTags(f reflect.StructField, name string) {
-	propSchema := s.Properties[name]
-	tags := getSwaggerTags(f)
-
-	if t, ok := tags["desc"]; ok {
-		propSchema.Description = t
-	}
-	if t, ok := tags["min"]; ok {
-		if m, err := strconv.ParseFloat(t, 64); err == nil {
-			propSchema.Minimum = &m
-		}
-	}
-	if t, ok := tags["max"]; ok {
-		if m, err := strconv.ParseFloat(t, 64); err == nil {
-			propSchema.Maximum = &m
-		}
-	}
-	if t, ok := tags["minLen"]; ok {
-		if m, err := strconv.Atoi(t); err == nil {
-			propSchema.MinLength = &m
+		if example != nil {
+			example = wrapExample(p.Items, example)
 		}
+	} else if format != "" {
+		p.Format = format
 	}
-	if t, ok := tags["maxLen"]; ok {
-		if m, err := strconv.Atoi(t); err == nil {
-			propSchema.MaxLength = &m
+	if example != nil {
+		if p.Extensions == nil {
+			p.Extensions = make(map[string]interface{})
 		}
+		p.Extensions["x-example"] = example
 	}
-	if _, ok := tags["required"]; ok {
-		s.Required = append(s.Required, name)
-	}
-	if _, ok := tags["readOnly"]; ok {
-		propSchema.ReadOnly = true
+	return nil
+}
+
+func (s *JSONSchema) handleSwaggerTags(owner reflect.Type, f reflect.StructField, name string, conf *config) error {
+	propSchema := s.Properties[name]
+	tags, err := getSwaggerTags(owner, f, conf)
+	if err != nil {
+		return err
 	}
 
-	convert := converter(f.Type)
-	if t, ok := tags["enum"]; ok {
-		enums := strings.Split(t, "|")
-		var es []interface{}
-		for _, s := range enums {
-			v, err := convert(s)
-			if err != nil {
-				continue
-			}
-			es = append(es, v)
-		}
-		propSchema.Enum = es
+	tags.setString("desc", &propSchema.Description)
+	tags.setString("title", &propSchema.Title)
+	tags.setFlag("readOnly", &propSchema.ReadOnly)
+	tags.setFlag("uniqueItems", &propSchema.UniqueItems)
+	if tags.has("required") {
+		s.Required = append(s.Required, name)
 	}
-	if t, ok := tags["default"]; ok {
-		v, err := convert(t)
-		if err == nil {
-			propSchema.DefaultValue = v
-		}
+	var format string
+	tags.setString("format", &format)
+	tags.setExtensions(&propSchema.Extensions)
+	err = firstError(
+		tags.setBound("min", "exclusiveMin", &propSchema.Minimum, &propSchema.ExclusiveMinimum),
+		tags.setBound("max", "exclusiveMax", &propSchema.Maximum, &propSchema.ExclusiveMaximum),
+		tags.setInt("minLen", &propSchema.MinLength),
+		tags.setInt("maxLen", &propSchema.MaxLength),
+		tags.setInt("minItems", &propSchema.MinItems),
+		tags.setInt("maxItems", &propSchema.MaxItems),
+		tags.setMultipleOf(&propSchema.MultipleOf),
+		tags.setPattern(&propSchema.Pattern),
+		tags.setEnum(&propSchema.Enum),
+		tags.setValue("default", &propSchema.DefaultValue),
+		tags.setValue("example", &propSchema.Example),
+	)
+	if err != nil {
+		return err
 	}
 
 	// Move part of tags in Schema to Items
 	if propSchema.Type == "array" {
 		items := propSchema.Items.latest()
 		items.Minimum = propSchema.Minimum
+		items.ExclusiveMinimum = propSchema.ExclusiveMinimum
 		items.Maximum = propSchema.Maximum
+		items.ExclusiveMaximum = propSchema.ExclusiveMaximum
 		items.MinLength = propSchema.MinLength
 		items.MaxLength = propSchema.MaxLength
+		items.MultipleOf = propSchema.MultipleOf
+		items.Pattern = propSchema.Pattern
 		items.Enum = propSchema.Enum
 		items.DefaultValue = propSchema.DefaultValue
+		if propSchema.Example != nil {
+			items.Example = propSchema.Example
+		}
+		if format != "" {
+			items.Format = format
+		}
 		propSchema.Minimum = nil
+		propSchema.ExclusiveMinimum = false
 		propSchema.Maximum = nil
+		propSchema.ExclusiveMaximum = false
 		propSchema.MinLength = nil
 		propSchema.MaxLength = nil
+		propSchema.MultipleOf = 0
+		propSchema.Pattern = ""
 		propSchema.Enum = nil
 		propSchema.DefaultValue = nil
+		propSchema.Example = nil
+	} else if format != "" {
+		propSchema.Format = format
 	}
+	return nil
 }
 
-func (h *Header) handleSwaggerTags(f reflect.StructField, name string) {
-	tags := getSwaggerTags(f)
-
-	if t, ok := tags["desc"]; ok {
-		h.Description = t
-	}
-	if t, ok := tags["min"]; ok {
-		if m, err := strconv.ParseFloat(t, 64); err == nil {
-			h.Minimum = &m
-		}
-	}
-	if t, ok := tags["max"]; ok {
-		if m, err := strconv.ParseFloat(t, 64); err == nil {
-			h.Maximum = &m
-		}
-	}
-	if t, ok := tags["minLen"]; ok {
-		if m, err := strconv.Atoi(t); err == nil {
-			h.MinLength = &m
-		}
-	}
-	if t, ok := tags["maxLen"]; ok {
-		if m, err := strconv.Atoi(t); err == nil {
-			h.MaxLength = &m
-		}
+func (h *Header) handleSwaggerTags(owner reflect.Type, f reflect.StructField, name string, conf *config) error {
+	tags, err := getSwaggerTags(owner, f, conf)
+	if err != nil {
+		return err
 	}
 
-	convert := converter(f.Type)
-	if t, ok := tags["enum"]; ok {
-		enums := strings.Split(t, "|")
-		var es []interface{}
-		for _, s := range enums {
-			v, err := convert(s)
-			if err != nil {
-				continue
-			}
-			es = append(es, v)
-		}
-		h.Enum = es
-	}
-	if t, ok := tags["default"]; ok {
-		v, err := convert(t)
-		if err == nil {
-			h.Default = v
-		}
+	tags.setString("desc", &h.Description)
+	tags.setFlag("uniqueItems", &h.UniqueItems)
+	var format string
+	var example interface{}
+	tags.setString("format", &format)
+	tags.setExtensions(&h.Extensions)
+	err = firstError(
+		tags.setBound("min", "exclusiveMin", &h.Minimum, &h.ExclusiveMinimum),
+		tags.setBound("max", "exclusiveMax", &h.Maximum, &h.ExclusiveMaximum),
+		tags.setInt("minLen", &h.MinLength),
+		tags.setInt("maxLen", &h.MaxLength),
+		tags.setInt("minItems", &h.MinItems),
+		tags.setInt("maxItems", &h.MaxItems),
+		tags.setMultipleOf(&h.MultipleOf),
+		tags.setPattern(&h.Pattern),
+		tags.setCollectionFormat(&h.CollectionFormat),
+		tags.setEnum(&h.Enum),
+		tags.setValue("default", &h.Default),
+		tags.setValue("example", &example),
+	)
+	if err != nil {
+		return err
 	}
 
 	// Move part of tags in Header to Items
 	if h.Type == "array" {
 		items := h.Items.latest()
 		items.Minimum = h.Minimum
+		items.ExclusiveMinimum = h.ExclusiveMinimum
 		items.Maximum = h.Maximum
+		items.ExclusiveMaximum = h.ExclusiveMaximum
 		items.MinLength = h.MinLength
 		items.MaxLength = h.MaxLength
+		items.MultipleOf = h.MultipleOf
+		items.Pattern = h.Pattern
 		items.Enum = h.Enum
 		items.Default = h.Default
+		if format != "" {
+			items.Format = format
+		}
 		h.Minimum = nil
+		h.ExclusiveMinimum = false
 		h.Maximum = nil
+		h.ExclusiveMaximum = false
 		h.MinLength = nil
 		h.MaxLength = nil
+		h.MultipleOf = 0
+		h.Pattern = ""
 		h.Enum = nil
 		h.Default = nil
+		if example != nil {
+			example = wrapExample(h.Items, example)
+		}
+	} else if format != "" {
+		h.Format = format
+	}
+	if example != nil {
+		if h.Extensions == nil {
+			h.Extensions = make(map[string]interface{})
+		}
+		h.Extensions["x-example"] = example
+	}
+	return nil
+}
+
+// wrapExample wraps v into one array per level of items.
+func wrapExample(items *Items, v interface{}) interface{} {
+	if items == nil {
+		return v
 	}
+	return []interface{}{wrapExample(items.Items, v)}
 }
 
 func (t *Items) latest() *Items {
@@ -1,6 +1,7 @@
 package echoswagger
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"net/http"
 	"strconv"
@@ -57,7 +58,7 @@ func TestParamSwaggerTags(t *testing.T) {
 		Q              string     `query:"q" swagger:"minLen(5),maxLen(8)"`
 		BrandIds       string     `query:"brandIds" swagger:"allowEmpty"`
 		Sortby         [][]string `query:"sortby" swagger:"default(id),allowEmpty"`
-		Order          []int      `query:"order" swagger:"enum(0|1|n)"`
+		Order          []int      `query:"order" swagger:"enum(0|1)"`
 		SkipCount      int        `query:"skipCount" swagger:"min(0),max(999)"`
 		MaxResultCount int        `query:"maxResultCount" swagger:"desc(items count in one page)"`
 	}
@@ -84,7 +85,7 @@ func TestHeaderSwaggerTags(t *testing.T) {
 		Q              string     `json:"q" swagger:"minLen(5),maxLen(8)"`
 		Enable         bool       `json:"-"`
 		Sortby         [][]string `json:"sortby" swagger:"default(id)"`
-		Order          []int      `json:"order" swagger:"enum(0|1|n)"`
+		Order          []int      `json:"order" swagger:"enum(0|1)"`
 		SkipCount      int        `json:"skipCount" swagger:"min(0),max(999)"`
 		MaxResultCount int        `json:"maxResultCount" swagger:"desc(items count in one page)"`
 	}
@@ -148,11 +149,11 @@ func TestXMLTags(t *testing.T) {
 
 func TestEnumInSchema(t *testing.T) {
 	type User struct {
-		Id      int64   `swagger:"enum(0|-1|200000|9.9)"`
-		Age     int     `swagger:"enum(0|-1|200000|9.9)"`
+		Id      int64   `swagger:"enum(0|-1|200000)"`
+		Age     int     `swagger:"enum(0|-1|200000)"`
 		Status  string  `swagger:"enum(normal|stop)"`
-		Amount  float64 `swagger:"enum(0|-0.1|ok|200.555)"`
-		Grade   float32 `swagger:"enum(0|-0.5|ok|200.5)"`
+		Amount  float64 `swagger:"enum(0|-0.1|200.555)"`
+		Grade   float32 `swagger:"enum(0|-0.5|200.5)"`
 		Deleted bool    `swagger:"enum(t|F),default(True)"`
 	}
 
@@ -224,3 +225,284 @@ func TestExampleInSchema(t *testing.T) {
 	assert.Equal(t, p["Grade"].Example, u.Grade)
 	assert.Equal(t, p["Deleted"].Example, u.Deleted)
 }
+
+func TestMoreSwaggerTags(t *testing.T) {
+	type Item struct {
+		Code  string   `swagger:"pattern(^[A-Z]{3}$),format(code),example(ABC),title(Item code)"`
+		Price float64  `swagger:"exclusiveMin(0),max(100),multipleOf(0.5)"`
+		Tags  []string `swagger:"minItems(1),maxItems(5),uniqueItems,pattern(^[a-z]+$),example(a)"`
+		Note  string   `swagger:"x-nullable(true),x-order(1),x-group(base)"`
+	}
+
+	t.Run("Schema", func(t *testing.T) {
+		a := prepareApi()
+		a.AddParamBody(&Item{}, "Body", "", true)
+		p := (*a.(*api).defs)["Item"].Schema.Properties
+		assert.Equal(t, "^[A-Z]{3}$", p["Code"].Pattern)
+		assert.Equal(t, "code", p["Code"].Format)
+		assert.Equal(t, "ABC", p["Code"].Example)
+		assert.Equal(t, "Item code", p["Code"].Title)
+		assert.Equal(t, float64(0), *p["Price"].Minimum)
+		assert.True(t, p["Price"].ExclusiveMinimum)
+		assert.Equal(t, float64(100), *p["Price"].Maximum)
+		assert.False(t, p["Price"].ExclusiveMaximum)
+		assert.Equal(t, 0.5, p["Price"].MultipleOf)
+		assert.Equal(t, 1, *p["Tags"].MinItems)
+		assert.Equal(t, 5, *p["Tags"].MaxItems)
+		assert.True(t, p["Tags"].UniqueItems)
+		assert.Equal(t, "", p["Tags"].Pattern)
+		assert.Equal(t, "^[a-z]+$", p["Tags"].Items.Pattern)
+		assert.Equal(t, "a", p["Tags"].Items.Example)
+		assert.Equal(t, map[string]interface{}{
+			"x-nullable": true,
+			"x-order":    float64(1),
+			"x-group":    "base",
+		}, p["Note"].Extensions)
+
+		b, err := json.Marshal(p["Note"])
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"type":"string","format":"string","xml":{"name":"Note"},"x-nullable":true,"x-order":1,"x-group":"base"}`, string(b))
+	})
+
+	t.Run("Param", func(t *testing.T) {
+		type Query struct {
+			Item
+			Ids []int `query:"ids" swagger:"collectionFormat(csv),example(1),format(int64),minItems(1)"`
+		}
+		a := prepareApi()
+		a.AddParamQueryNested(&Query{})
+		o := a.(*api).operation
+		assert.Len(t, o.Parameters, 5)
+		assert.Equal(t, "^[A-Z]{3}$", o.Parameters[0].Pattern)
+		assert.Equal(t, "code", o.Parameters[0].Format)
+		assert.Equal(t, "ABC", o.Parameters[0].Extensions["x-example"])
+		assert.True(t, o.Parameters[1].ExclusiveMinimum)
+		assert.Equal(t, 0.5, o.Parameters[1].MultipleOf)
+		assert.Equal(t, "csv", o.Parameters[4].CollectionFormat)
+		assert.Equal(t, "int64", o.Parameters[4].Items.Format)
+		assert.Equal(t, 1, *o.Parameters[4].MinItems)
+		assert.Equal(t, []interface{}{1}, o.Parameters[4].Extensions["x-example"])
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		a := prepareApi()
+		a.AddResponse(http.StatusOK, "Resp", nil, Item{})
+		h := a.(*api).operation.Responses[strconv.Itoa(http.StatusOK)].Headers
+		assert.Equal(t, "^[A-Z]{3}$", h["Code"].Pattern)
+		assert.Equal(t, "ABC", h["Code"].Extensions["x-example"])
+		assert.True(t, h["Tags"].UniqueItems)
+		assert.Equal(t, "^[a-z]+$", h["Tags"].Items.Pattern)
+		assert.Equal(t, "base", h["Note"].Extensions["x-group"])
+	})
+}
+
+func TestMalformedSwaggerTags(t *testing.T) {
+	tests := []struct {
+		p    interface{}
+		name string
+	}{
+		{
+			p: &struct {
+				Age int `swagger:"min(a)"`
+			}{},
+			name: "Minimum",
+		},
+		{
+			p: &struct {
+				Name string `swagger:"maxLen(-1)"`
+			}{},
+			name: "MaxLength",
+		},
+		{
+			p: &struct {
+				Age int `swagger:"enum(0|1|n)"`
+			}{},
+			name: "Enum",
+		},
+		{
+			p: &struct {
+				Deleted bool `swagger:"default(yes)"`
+			}{},
+			name: "Default",
+		},
+		{
+			p: &struct {
+				Code string `swagger:"pattern([a-z)"`
+			}{},
+			name: "Pattern",
+		},
+		{
+			p: &struct {
+				Price float64 `swagger:"multipleOf(0)"`
+			}{},
+			name: "MultipleOf",
+		},
+		{
+			p: &struct {
+				Price float64 `swagger:"min(1),exclusiveMin(1)"`
+			}{},
+			name: "Conflict",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Panics(t, func() {
+				prepareApi().AddParamBody(tt.p, "Body", "", true)
+			})
+			assert.Panics(t, func() {
+				prepareApi().AddParamQueryNested(tt.p)
+			})
+			assert.Panics(t, func() {
+				prepareApi().AddResponse(http.StatusOK, "Resp", nil, tt.p)
+			})
+		})
+	}
+
+	t.Run("CollectionFormat", func(t *testing.T) {
+		assert.Panics(t, func() {
+			prepareApi().AddParamQueryNested(&struct {
+				Ids []int `swagger:"collectionFormat(json)"`
+			}{})
+		})
+	})
+}
+
+func TestParseSwaggerTag(t *testing.T) {
+	tests := []struct {
+		tag    string
+		values map[string]string
+		err    bool
+		name   string
+	}{
+		{
+			tag:    "desc(Address of Spot),required",
+			values: map[string]string{"desc": "Address of Spot", "required": ""},
+			name:   "Basic",
+		},
+		{
+			tag:    "desc(Comma, separated), min(1)",
+			values: map[string]string{"desc": "Comma, separated", "min": "1"},
+			name:   "Comma",
+		},
+		{
+			tag:    `pattern(^(a|b)\)$),enum('a,b'|c\|d)`,
+			values: map[string]string{"pattern": `^(a|b)\)$`, "enum": `'a,b'|c\|d`},
+			name:   "Nested",
+		},
+		{
+			tag:    "desc('It''s (open')",
+			values: map[string]string{"desc": "'It''s (open'"},
+			name:   "Quote",
+		},
+		{
+			tag:    "desc(user's name),,required,",
+			values: map[string]string{"desc": "user's name", "required": ""},
+			name:   "Apostrophe",
+		},
+		{
+			tag:  "desc(a",
+			err:  true,
+			name: "Missing parenthesis",
+		},
+		{
+			tag:    "desc('a)",
+			values: map[string]string{"desc": "'a"},
+			name:   "Unterminated quote",
+		},
+		{
+			tag:    "desc('a'b)",
+			values: map[string]string{"desc": "'a'b"},
+			name:   "After quote",
+		},
+		{
+			tag:    "desc(smile :)),required",
+			values: map[string]string{"desc": "smile :)", "required": ""},
+			name:   "Unbalanced close",
+		},
+		{
+			tag:    "desc(a ( b), min(1)",
+			values: map[string]string{"desc": "a ( b", "min": "1"},
+			name:   "Unbalanced open",
+		},
+		{
+			tag:  "desc(a)b",
+			err:  true,
+			name: "After value",
+		},
+		{
+			tag:  "(a)",
+			err:  true,
+			name: "Missing name",
+		},
+		{
+			tag:    "min(1),min(2)",
+			values: map[string]string{"min": "2"},
+			name:   "Duplicate",
+		},
+		{
+			tag:  `desc(a\`,
+			err:  true,
+			name: "Trailing backslash",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := parseSwaggerTag(tt.tag)
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.values, values)
+			}
+		})
+	}
+
+	assert.Equal(t, "a,b", unquoteTagValue(`a\,b`))
+	assert.Equal(t, `^\d+\(`, unquoteTagValue(`^\d+\(`))
+	assert.Equal(t, "It's (open", unquoteTagValue("'It''s (open'"))
+	assert.Equal(t, []string{"a,b", "c|d", "e"}, splitTagValue(`'a,b'|c\|d| e`))
+	assert.Equal(t, []string{"a|b", "c"}, splitTagValue(`'a|b' | c`))
+	assert.Equal(t, []string{"a", "b"}, splitTagValue("a | b"))
+}
+
+func TestEscapedSwaggerTags(t *testing.T) {
+	type Spot struct {
+		Address string `swagger:"desc(Comma, separated (and nested)),pattern('^\\(\\d+\\)$'),enum('a|b'|c\\,d)"`
+	}
+
+	a := prepareApi()
+	a.AddParamBody(&Spot{}, "Body", "", true)
+	p := (*a.(*api).defs)["Spot"].Schema.Properties["Address"]
+	assert.Equal(t, "Comma, separated (and nested)", p.Description)
+	assert.Equal(t, `^\(\d+\)$`, p.Pattern)
+	assert.Equal(t, []interface{}{"a|b", "c,d"}, p.Enum)
+
+	type Lenient struct {
+		Code string `swagger:"desc(smile :)),pattern(^\\(\\d+\\)$),enum(a | b),enum(c | d)"`
+	}
+	a = prepareApi()
+	a.AddParamBody(&Lenient{}, "Body", "", true)
+	p = (*a.(*api).defs)["Lenient"].Schema.Properties["Code"]
+	assert.Equal(t, "smile :)", p.Description)
+	assert.Equal(t, `^\(\d+\)$`, p.Pattern)
+	assert.Equal(t, []interface{}{"c", "d"}, p.Enum)
+
+	type Broken struct {
+		Address string `swagger:"desc(Address"`
+	}
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			if assert.True(t, ok) {
+				assert.EqualError(t, err, "echoswagger: invalid swagger tag of field Broken.Address: missing ')'")
+			}
+		}()
+		prepareApi().AddParamBody(&Broken{}, "Body", "", true)
+	}()
+	assert.Panics(t, func() {
+		prepareApi().AddParamQueryNested(&Broken{})
+	})
+	assert.Panics(t, func() {
+		prepareApi().AddResponse(http.StatusOK, "Resp", nil, &Broken{})
+	})
+}
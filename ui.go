@@ -0,0 +1,114 @@
+package echoswagger
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// SwaggerUIConfig is the configuration of Swagger UI, refer to
+// https://github.com/swagger-api/swagger-ui/blob/master/docs/usage/configuration.md
+// Zero values leave options to defaults of Swagger UI.
+type SwaggerUIConfig struct {
+	// DocExpansion is one of "list", "full" and "none".
+	DocExpansion string
+	// DefaultModelsExpandDepth of -1 hides models.
+	DefaultModelsExpandDepth *int
+	DefaultModelExpandDepth  *int
+	// DeepLinking is true if it's nil.
+	DeepLinking *bool
+	// Filter enables the filter bar, FilterText enables it with initial text.
+	Filter     bool
+	FilterText string
+	// PersistAuthorization keeps authorization data after browser is closed.
+	PersistAuthorization   bool
+	TryItOutEnabled        bool
+	DisplayRequestDuration bool
+	DisplayOperationId     bool
+	// SupportedSubmitMethods are HTTP methods which "Try it out" is enabled for,
+	// all methods if it's nil, none if it's empty.
+	SupportedSubmitMethods []string
+	// OAuth2RedirectUrl is the page served under docPath if it's empty.
+	OAuth2RedirectUrl string
+	// InitOAuth configures the OAuth2 client used by "Authorize".
+	InitOAuth *SwaggerUIOAuth
+	// Raw is a JSON object of options which overrides all of above,
+	// for options not covered by this struct.
+	Raw json.RawMessage
+}
+
+// SwaggerUIOAuth is the configuration of `ui.initOAuth`.
+type SwaggerUIOAuth struct {
+	ClientId                                  string            `json:"clientId,omitempty"`
+	ClientSecret                              string            `json:"clientSecret,omitempty"`
+	Realm                                     string            `json:"realm,omitempty"`
+	AppName                                   string            `json:"appName,omitempty"`
+	ScopeSeparator                            string            `json:"scopeSeparator,omitempty"`
+	Scopes                                    []string          `json:"scopes,omitempty"`
+	AdditionalQueryStringParams               map[string]string `json:"additionalQueryStringParams,omitempty"`
+	UseBasicAuthenticationWithAccessCodeGrant bool              `json:"useBasicAuthenticationWithAccessCodeGrant,omitempty"`
+	UsePkceWithAuthorizationCodeGrant         bool              `json:"usePkceWithAuthorizationCodeGrant,omitempty"`
+}
+
+// options returns options passed to SwaggerUIBundle.
+func (c SwaggerUIConfig) options() (map[string]interface{}, error) {
+	opts := make(map[string]interface{})
+	switch c.DocExpansion {
+	case "":
+	case "list", "full", "none":
+		opts["docExpansion"] = c.DocExpansion
+	default:
+		return nil, errors.New("echoswagger: invalid docExpansion of Swagger UI")
+	}
+	if c.DefaultModelsExpandDepth != nil {
+		opts["defaultModelsExpandDepth"] = *c.DefaultModelsExpandDepth
+	}
+	if c.DefaultModelExpandDepth != nil {
+		opts["defaultModelExpandDepth"] = *c.DefaultModelExpandDepth
+	}
+	if c.DeepLinking != nil {
+		opts["deepLinking"] = *c.DeepLinking
+	}
+	if c.FilterText != "" {
+		opts["filter"] = c.FilterText
+	} else if c.Filter {
+		opts["filter"] = true
+	}
+	flags := map[string]bool{
+		"persistAuthorization":   c.PersistAuthorization,
+		"tryItOutEnabled":        c.TryItOutEnabled,
+		"displayRequestDuration": c.DisplayRequestDuration,
+		"displayOperationId":     c.DisplayOperationId,
+	}
+	for k, v := range flags {
+		if v {
+			opts[k] = true
+		}
+	}
+	if c.SupportedSubmitMethods != nil {
+		methods := make([]string, 0, len(c.SupportedSubmitMethods))
+		for _, m := range c.SupportedSubmitMethods {
+			m = strings.ToLower(m)
+			switch m {
+			case "get", "put", "post", "delete", "options", "head", "patch", "trace":
+				methods = append(methods, m)
+			default:
+				return nil, errors.New("echoswagger: invalid supportedSubmitMethods of Swagger UI")
+			}
+		}
+		opts["supportedSubmitMethods"] = methods
+	}
+	if c.OAuth2RedirectUrl != "" {
+		opts["oauth2RedirectUrl"] = c.OAuth2RedirectUrl
+	}
+	if len(c.Raw) > 0 {
+		var raw map[string]interface{}
+		if err := json.Unmarshal(c.Raw, &raw); err != nil {
+			return nil, errors.New("echoswagger: raw options of Swagger UI must be a JSON object")
+		}
+		for k, v := range raw {
+			opts[k] = v
+		}
+	}
+	return opts, nil
+}
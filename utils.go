@@ -1,10 +1,16 @@
 package echoswagger
 
 import (
+	"mime/multipart"
 	"reflect"
 	"strings"
+	"time"
 )
 
+// fileType is the type of uploaded files, documented as type "file" in formData,
+// and as type "string" with format "binary" in schemas.
+var fileType = reflect.TypeOf(multipart.FileHeader{})
+
 func contains(list []string, s string) bool {
 	for _, t := range list {
 		if t == s {
@@ -42,6 +48,22 @@ func equals(a []string, b []string) bool {
 	return true
 }
 
+func containsType(list []reflect.Type, t reflect.Type) bool {
+	for _, l := range list {
+		if l == t {
+			return true
+		}
+	}
+	return false
+}
+
+func isStructType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{}) && t != fileType
+}
+
 func indirect(v reflect.Value) reflect.Value {
 	if v.Kind() == reflect.Ptr {
 		ev := v.Elem()
@@ -104,3 +126,13 @@ func trimSuffixSlash(s, suffix string) string {
 	suffix = removeTrailingSlash(suffix)
 	return strings.TrimSuffix(s, suffix)
 }
+
+// firstError returns the first non-nil error in errs.
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
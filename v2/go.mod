@@ -0,0 +1,10 @@
+module github.com/pangpanglabs/echoswagger/v2
+
+go 1.12
+
+require (
+	github.com/labstack/echo/v4 v4.1.17
+	github.com/labstack/gommon v0.3.0
+	github.com/stretchr/testify v1.4.0
+	gopkg.in/yaml.v2 v2.2.2
+)
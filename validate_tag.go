@@ -0,0 +1,185 @@
+package echoswagger
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var validateFormats = map[string]string{
+	"email":    "email",
+	"url":      "uri",
+	"uri":      "uri",
+	"uuid":     "uuid",
+	"uuid3":    "uuid",
+	"uuid4":    "uuid",
+	"uuid5":    "uuid",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"hostname": "hostname",
+}
+
+var validatePatterns = map[string]string{
+	"alpha":       `^[a-zA-Z]+$`,
+	"alphanum":    `^[a-zA-Z0-9]+$`,
+	"numeric":     `^[-+]?[0-9]+(?:\.[0-9]+)?$`,
+	"number":      `^[0-9]+$`,
+	"hexadecimal": `^(0[xX])?[0-9a-fA-F]+$`,
+}
+
+// addValidateTags translates go-playground/validator tag of the field
+// to swagger tags, tags already exist in t are not overwritten.
+// Rules which can't be described by swagger tags are ignored.
+func (t *swaggerTags) addValidateTags(tagName string) {
+	tag := t.field.Tag.Get(tagName)
+	if tag == "" || tag == "-" {
+		return
+	}
+	ft := t.field.Type
+	for ft.Kind() == reflect.Ptr {
+		ft = ft.Elem()
+	}
+	items := false
+	for _, rule := range strings.Split(tag, ",") {
+		name, param := rule, ""
+		if i := strings.Index(rule, "="); i >= 0 {
+			name, param = rule[:i], rule[i+1:]
+		}
+		name = strings.TrimSpace(name)
+		param = strings.NewReplacer("0x2C", ",", "0x7C", "|").Replace(param)
+		if strings.Contains(name, "|") {
+			continue
+		}
+		if name == "dive" {
+			if ft.Kind() != reflect.Slice && ft.Kind() != reflect.Array {
+				return
+			}
+			ft = ft.Elem()
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			items = true
+			continue
+		}
+
+		switch name {
+		case "required":
+			if !items {
+				t.addTag("required", "")
+			}
+		case "unique":
+			t.addTag("uniqueItems", "")
+		case "oneof":
+			var es []string
+			for _, v := range splitValidateParams(param) {
+				es = append(es, "'"+strings.Replace(v, "'", "''", -1)+"'")
+			}
+			t.addTag("enum", strings.Join(es, "|"))
+		case "datetime":
+			switch param {
+			case "2006-01-02":
+				t.addTag("format", "date")
+			case time.RFC3339, time.RFC3339Nano:
+				t.addTag("format", "date-time")
+			}
+		case "min", "max", "len", "gt", "gte", "lt", "lte":
+			t.addBoundTag(name, param, ft, items)
+		default:
+			if f, ok := validateFormats[name]; ok {
+				t.addTag("format", f)
+			} else if p, ok := validatePatterns[name]; ok {
+				t.addTag("pattern", "'"+strings.Replace(p, "'", "''", -1)+"'")
+			}
+		}
+	}
+}
+
+// addBoundTag translates rules min, max, len, gt, gte, lt and lte,
+// which meanings depend on the type of field.
+func (t *swaggerTags) addBoundTag(name, param string, ft reflect.Type, items bool) {
+	n, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+	switch ft.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		keys := map[string]string{"min": "min", "gte": "min", "gt": "exclusiveMin",
+			"max": "max", "lte": "max", "lt": "exclusiveMax"}
+		if k, ok := keys[name]; ok {
+			t.addTag(k, param)
+		}
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		minKey, maxKey := "minLen", "maxLen"
+		if ft.Kind() != reflect.String {
+			if items {
+				return
+			}
+			minKey, maxKey = "minItems", "maxItems"
+		}
+		switch name {
+		case "min", "gte":
+			t.addLenTag(minKey, math.Ceil(n))
+		case "gt":
+			t.addLenTag(minKey, math.Floor(n)+1)
+		case "max", "lte":
+			t.addLenTag(maxKey, math.Floor(n))
+		case "lt":
+			t.addLenTag(maxKey, math.Ceil(n)-1)
+		case "len":
+			t.addLenTag(minKey, n)
+			t.addLenTag(maxKey, n)
+		}
+	}
+}
+
+// addLenTag adds a length tag, a negative maximum length can't be satisfied
+// and isn't a valid spec, so it's skipped, and a negative minimum is clamped to 0.
+func (t *swaggerTags) addLenTag(key string, n float64) {
+	if n < 0 {
+		if key == "maxLen" || key == "maxItems" {
+			return
+		}
+		n = 0
+	}
+	t.addTag(key, strconv.Itoa(int(n)))
+}
+
+// addTag adds tag key with raw value, if neither it nor the tag conflicts with it exists.
+func (t *swaggerTags) addTag(key, value string) {
+	conflicts := map[string]string{"min": "exclusiveMin", "exclusiveMin": "min",
+		"max": "exclusiveMax", "exclusiveMax": "max"}
+	if t.has(key) || t.has(conflicts[key]) {
+		return
+	}
+	t.values[key] = value
+}
+
+// splitValidateParams splits params of rule oneof, which are separated by space
+// and may be quoted by "'".
+func splitValidateParams(param string) []string {
+	var r []string
+	for len(param) > 0 {
+		param = strings.TrimLeft(param, " ")
+		if param == "" {
+			break
+		}
+		if param[0] == '\'' {
+			if i := strings.Index(param[1:], "'"); i >= 0 {
+				r = append(r, param[1:i+1])
+				param = param[i+2:]
+				continue
+			}
+		}
+		i := strings.Index(param, " ")
+		if i < 0 {
+			i = len(param)
+		}
+		r = append(r, param[:i])
+		param = param[i:]
+	}
+	return r
+}
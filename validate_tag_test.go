@@ -0,0 +1,107 @@
+package echoswagger
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTags(t *testing.T) {
+	type User struct {
+		Name    string   `json:"name" query:"name" validate:"required,min=1,max=100"`
+		Age     int      `json:"age" query:"age" validate:"gt=0,lte=150" swagger:"max(99)"`
+		Color   string   `json:"color" query:"color" validate:"oneof=red green 'light blue'"`
+		Email   string   `json:"email" query:"email" validate:"omitempty,email"`
+		Id      string   `json:"id" query:"id" validate:"uuid4"`
+		Code    string   `json:"code" query:"code" validate:"len=3,alpha"`
+		Tags    []string `json:"tags" query:"tags" validate:"min=1,unique,dive,max=10"`
+		Kind    string   `json:"kind" query:"kind" validate:"rgb|rgba" swagger:"format(color)"`
+		Skipped string   `json:"skipped" query:"skipped" validate:"-"`
+	}
+
+	prepare := func(tag string) Api {
+		r := New(echo.New(), "doc/", nil)
+		r.SetValidateTag(tag)
+		var h echo.HandlerFunc
+		return r.POST("/", h)
+	}
+
+	t.Run("Schema", func(t *testing.T) {
+		a := prepare("validate")
+		a.AddParamBody(&User{}, "body", "", true)
+		s := (*a.(*api).defs)["User"].Schema
+		p := s.Properties
+		assert.ElementsMatch(t, []string{"name"}, s.Required)
+		assert.Equal(t, 1, *p["name"].MinLength)
+		assert.Equal(t, 100, *p["name"].MaxLength)
+		assert.Equal(t, float64(0), *p["age"].Minimum)
+		assert.True(t, p["age"].ExclusiveMinimum)
+		assert.Equal(t, float64(99), *p["age"].Maximum)
+		assert.Equal(t, []interface{}{"red", "green", "light blue"}, p["color"].Enum)
+		assert.Equal(t, "email", p["email"].Format)
+		assert.Equal(t, "uuid", p["id"].Format)
+		assert.Equal(t, 3, *p["code"].MinLength)
+		assert.Equal(t, 3, *p["code"].MaxLength)
+		assert.Equal(t, "^[a-zA-Z]+$", p["code"].Pattern)
+		assert.Equal(t, 1, *p["tags"].MinItems)
+		assert.True(t, p["tags"].UniqueItems)
+		assert.Nil(t, p["tags"].MaxItems)
+		assert.Equal(t, 10, *p["tags"].Items.MaxLength)
+		assert.Equal(t, "color", p["kind"].Format)
+		assert.Equal(t, "string", p["skipped"].Format)
+	})
+
+	t.Run("Param", func(t *testing.T) {
+		a := prepare("validate")
+		a.AddParamQueryNested(&User{})
+		ps := a.(*api).operation.Parameters
+		assert.True(t, ps[0].Required)
+		assert.Equal(t, 100, *ps[0].MaxLength)
+		assert.Equal(t, []interface{}{"red", "green", "light blue"}, ps[2].Enum)
+		assert.Equal(t, 10, *ps[6].Items.MaxLength)
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		a := prepare("validate")
+		a.AddResponse(http.StatusOK, "", nil, &User{})
+		h := a.(*api).operation.Responses["200"].Headers
+		assert.Equal(t, 100, *h["name"].MaxLength)
+		assert.Equal(t, "uuid", h["id"].Format)
+	})
+
+	t.Run("Bounds", func(t *testing.T) {
+		type Bounds struct {
+			Empty   string    `json:"empty" validate:"lt=0"`
+			Short   string    `json:"short" validate:"lt=1,gt=-3"`
+			Items   []string  `json:"items" validate:"lt=0,gte=-1"`
+			Date    time.Time `json:"date" validate:"datetime=2006-01-02"`
+			Time    time.Time `json:"time" validate:"datetime=2006-01-02T15:04:05Z07:00"`
+			Layout  string    `json:"layout" validate:"datetime=2006-01-02 15:04:05"`
+			Decimal string    `json:"decimal" validate:"gt=1.5,lt=3.5"`
+		}
+		a := prepare("validate")
+		a.AddParamBody(&Bounds{}, "body", "", true)
+		p := (*a.(*api).defs)["Bounds"].Schema.Properties
+		assert.Nil(t, p["empty"].MaxLength)
+		assert.Equal(t, 0, *p["short"].MaxLength)
+		assert.Equal(t, 0, *p["short"].MinLength)
+		assert.Nil(t, p["items"].MaxItems)
+		assert.Equal(t, 0, *p["items"].MinItems)
+		assert.Equal(t, "date", p["date"].Format)
+		assert.Equal(t, "date-time", p["time"].Format)
+		assert.Equal(t, "string", p["layout"].Format)
+		assert.Equal(t, 2, *p["decimal"].MinLength)
+		assert.Equal(t, 3, *p["decimal"].MaxLength)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		a := prepare("")
+		a.AddParamBody(&User{}, "body", "", true)
+		s := (*a.(*api).defs)["User"].Schema
+		assert.Len(t, s.Required, 0)
+		assert.Nil(t, s.Properties["name"].MinLength)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"regexp"
 	"time"
+
+	"github.com/labstack/echo"
 )
 
 var emailRegexp = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
@@ -21,10 +23,16 @@ func isValidURL(str string) bool {
 	return true
 }
 
-func isValidParam(t reflect.Type, nest, inner bool) bool {
+func isValidParam(t reflect.Type, in ParamInType, nest, inner bool, pres ...reflect.Type) bool {
 	if t == nil {
 		return false
 	}
+	if containsType(pres, t) {
+		return true
+	}
+	if t == fileType {
+		return in == ParamInFormData
+	}
 	switch t.Kind() {
 	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
 		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
@@ -33,19 +41,21 @@ func isValidParam(t reflect.Type, nest, inner bool) bool {
 			return true
 		}
 	case reflect.Array, reflect.Slice:
-		return isValidParam(t.Elem(), nest, true)
+		return isValidParam(t.Elem(), in, nest, true)
 	case reflect.Ptr:
-		return isValidParam(t.Elem(), nest, inner)
+		return isValidParam(t.Elem(), in, nest, inner, pres...)
 	case reflect.Struct:
 		if t == reflect.TypeOf(time.Time{}) && (!nest || nest && inner) {
 			return true
 		} else if !inner {
+			pres = append(pres, t)
 			for i := 0; i < t.NumField(); i++ {
-				inner := true
-				if t.Field(i).Type.Kind() == reflect.Struct && t.Field(i).Anonymous {
-					inner = false
+				f := t.Field(i)
+				if isHiddenField(f) {
+					continue
 				}
-				if !isValidParam(t.Field(i).Type, nest, inner) {
+				inner := !isEmbeddedStruct(f, in) && paramPrefix(f, in) == ""
+				if !isValidParam(f.Type, in, nest, inner, pres...) {
 					return false
 				}
 			}
@@ -96,6 +106,9 @@ func isValidSchema(t reflect.Type, inner bool, pres ...reflect.Type) bool {
 			return true
 		}
 		for i := 0; i < t.NumField(); i++ {
+			if isHiddenField(t.Field(i)) {
+				continue
+			}
 			if !isValidSchema(t.Field(i).Type, true, pres...) {
 				return false
 			}
@@ -124,6 +137,23 @@ func isBasicType(t reflect.Type) bool {
 	return false
 }
 
+// isValidMethod reports whether swagger can describe operations of method.
+func isValidMethod(method string) bool {
+	switch method {
+	case echo.GET, echo.POST, echo.PUT, echo.DELETE, echo.OPTIONS, echo.HEAD, echo.PATCH:
+		return true
+	}
+	return false
+}
+
+func isValidRenderer(r UIRenderer) bool {
+	switch r {
+	case UISwaggerUI, UIReDoc, UIRapiDoc, UIScalar:
+		return true
+	}
+	return false
+}
+
 func isValidScheme(s string) bool {
 	if s == "http" || s == "https" || s == "ws" || s == "wss" {
 		return true
@@ -1,13 +1,17 @@
 package echoswagger
 
 import (
+	"net"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/labstack/echo"
 )
 
+//go:generate go run gen_v2.go
+
 /*
 TODO:
 1.pattern
@@ -44,6 +48,29 @@ type ApiRouter interface {
 
 	// PATCH overrides `Echo#PATCH()` and creates Api.
 	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api
+
+	// CONNECT overrides `Echo#CONNECT()` and creates Api.
+	// Swagger 2.0 can't describe CONNECT operations, hide it by `Api.Hidden()`.
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api
+
+	// TRACE overrides `Echo#TRACE()` and creates Api.
+	// Swagger 2.0 can't describe TRACE operations, hide it by `Api.Hidden()`.
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api
+
+	// Any overrides `Echo#Any()` and creates Api of all the routes,
+	// routes of methods which swagger can't describe are hidden.
+	Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api
+
+	// Match overrides `Echo#Match()` and creates Api of all the routes.
+	Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api
+
+	// Static works like `Echo#Static()` and creates Api of the routes,
+	// which are documented as file downloads.
+	Static(prefix, root string) Api
+
+	// File works like `Echo#File()` and creates Api of the route,
+	// which is documented as a file download.
+	File(path, file string, m ...echo.MiddlewareFunc) Api
 }
 
 type ApiRoot interface {
@@ -52,7 +79,8 @@ type ApiRoot interface {
 	// Bind an existing Echo group
 	// note: this is usually used to create nested Echo groups
 	// you still need to add route by ApiGroup instance but not the param Echo group
-	// any routes created directly by the Echo group will not be added to the swagger spec.
+	// any routes created directly by the Echo group will not be added to the swagger spec,
+	// unless they're discovered by `SetDiscovery`.
 	BindGroup(name string, g *echo.Group) ApiGroup
 
 	// Group overrides `Echo#Group()` and creates ApiGroup.
@@ -77,18 +105,81 @@ type ApiRoot interface {
 	AddSecurityOAuth2(name, desc string, flow OAuth2FlowType, authorizationUrl, tokenUrl string, scopes map[string]string) ApiRoot
 
 	// SetUI sets UI setting.
-	// If DetachSpec is false, HideTop will not take effect
+	// If DetachSpec is false and no spec is added by AddSpec, HideTop will not take effect
 	SetUI(ui UISetting) ApiRoot
 
 	// SetScheme sets available protocol schemes.
 	SetScheme(schemes ...string) ApiRoot
 
+	// SetHost sets how to resolve host, basePath and schemes of spec,
+	// they're resolved from `Referer` header by default.
+	SetHost(h HostSetting) ApiRoot
+
+	// SetDiscovery sets how to handle routes registered directly on echo,
+	// they're ignored by default.
+	SetDiscovery(mode DiscoveryMode) ApiRoot
+
+	// Coverage reports how well the spec is documented, it's also served
+	// at "<docPath>/coverage.json" if `UISetting.Coverage` is set.
+	Coverage() (*CoverageReport, error)
+
+	// SetIntrospection makes operations carry "x-handler", the name of the
+	// handler, "x-middleware", names of middleware of the route and its
+	// ApiGroup, and "x-source", the file and line registering the route.
+	// It only affects routes added after it's called. "x-middleware" only
+	// lists middleware passed to the route and `Group`, middleware added
+	// by `Echo#Use` or `echo.Group#Use` isn't recorded, so it may not be
+	// the whole chain.
+	SetIntrospection(enable bool) ApiRoot
+
+	// SetValidateTag makes `AddParam...` and `AddResponse` read rules of
+	// go-playground/validator in the struct tag with name, usually "validate".
+	// Rules conflict with `swagger` tag are ignored. Empty name disables it.
+	// It only affects parameters and responses added after it's called.
+	SetValidateTag(name string) ApiRoot
+
+	// AddSpec adds spec of root, named name, to the spec selector of Swagger UI.
+	// The spec is served at "<docPath>/<name>/swagger.json" of this ApiRoot,
+	// and root usually registers its routes on the same Echo instance.
+	AddSpec(name string, root ApiRoot) ApiRoot
+
+	// AddAudienceSpec serves a spec only containing operations visible to
+	// audience at "<docPath>/<audience>/swagger.json", and adds it to the
+	// spec selector of Swagger UI. See `Api.SetAudiences`.
+	AddAudienceSpec(audience string) ApiRoot
+
 	// GetRaw returns raw `Swagger`. Only special case should use.
 	GetRaw() *Swagger
 
 	// SetRaw sets raw `Swagger` to ApiRoot. Only special case should use.
+	// Paths of it are kept when spec is generated, so a spec designed first
+	// can be loaded by `LoadSpec`, set by it and implemented by `Implement`.
 	SetRaw(s *Swagger) ApiRoot
 
+	// MergeSpec deep-merges a spec fragment in JSON or YAML, such as extra
+	// definitions, descriptions, examples, tags or extensions, into the
+	// generated spec. Different values at the same place are conflicts,
+	// which are returned as error of generating spec. It panics if spec
+	// is generated, fragments must be merged before the first spec request.
+	MergeSpec(fragment []byte) ApiRoot
+
+	// OnSpec adds a hook transforming spec after it's generated, and after
+	// host and basePath are resolved for specs served under docPath. Hooks
+	// run in the order they're added, on a copy of spec, and their errors
+	// are returned by `GetSpec` or served as errors of spec.
+	OnSpec(hook func(*Swagger) error) ApiRoot
+
+	// Implement registers h as handler of the operation with operationId
+	// in spec set by `SetRaw`, the route is created from method and path
+	// of the operation prefixed by basePath of spec, e.g. "/pets/{id}"
+	// becomes "/v1/pets/:id" with basePath "/v1". It panics if a path
+	// parameter doesn't end a segment, or spec is generated.
+	Implement(operationId string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) ApiRoot
+
+	// Unimplemented returns operations in spec without handlers, as their
+	// operationId, or method and path if they have no operationId.
+	Unimplemented() []string
+
 	// Echo returns the embedded Echo instance
 	Echo() *echo.Echo
 }
@@ -112,6 +203,13 @@ type ApiGroup interface {
 	// Should only use when Security type is oauth2.
 	SetSecurityWithScope(s map[string][]string) ApiGroup
 
+	// Hidden keeps all routes within the ApiGroup out of spec.
+	Hidden() ApiGroup
+
+	// SetAudiences sets audiences of all operations within the ApiGroup,
+	// which have no audiences set by `Api.SetAudiences`.
+	SetAudiences(audiences ...string) ApiGroup
+
 	// EchoGroup returns the embedded `echo.Group` instance.
 	EchoGroup() *echo.Group
 }
@@ -151,6 +249,20 @@ type Api interface {
 	// AddParamFile adds file parameter.
 	AddParamFile(name, desc string, required bool) Api
 
+	// AddParamFiles adds file parameter accepting multiple files with name.
+	// It's an array of files in formData, which converts to an array of
+	// binary strings in OpenAPI 3.
+	AddParamFiles(name, desc string, required bool) Api
+
+	// AddParamFormJSON adds formData parameter containing p in JSON, like
+	// metadata sent together with files. Its schema is in "x-schema".
+	AddParamFormJSON(p interface{}, name, desc string, required bool) Api
+
+	// SetParamContentType sets content types accepted by the formData
+	// parameter with name, in "x-content-type" like `encoding.contentType`
+	// of multipart request in OpenAPI 3.
+	SetParamContentType(name string, types ...string) Api
+
 	// AddResponse adds response for Api.
 	// Header must be struct type.
 	AddResponse(code int, desc string, schema interface{}, header interface{}) Api
@@ -185,6 +297,13 @@ type Api interface {
 	// Should only use when Security type is oauth2.
 	SetSecurityWithScope(s map[string][]string) Api
 
+	// Hidden keeps the Api out of spec, the route is still registered.
+	Hidden() Api
+
+	// SetAudiences sets audiences the Api is visible to in specs added by
+	// `AddAudienceSpec`. Api without audiences is visible to all of them.
+	SetAudiences(audiences ...string) Api
+
 	// Route returns the embedded `echo.Route` instance.
 	Route() *echo.Route
 }
@@ -192,6 +311,11 @@ type Api interface {
 type routers struct {
 	apis []api
 	defs *RawDefineDic
+	conf *config
+	// owned are routes created by Roots sharing the echo.
+	owned *routeSet
+	// middleware are names of middleware of the group.
+	middleware []string
 }
 
 type Root struct {
@@ -200,22 +324,61 @@ type Root struct {
 	echo   *echo.Echo
 	groups []group
 	ui     UISetting
-	once   sync.Once
-	err    error
+	// docPath and docMiddlewares are used to register doc routes.
+	docPath        string
+	docMiddlewares []echo.MiddlewareFunc
+	docRoutes      []string
+	docNets        []*net.IPNet
+	// specs are additional specs listed in the spec selector.
+	specs []specView
+	// audiences of operations, set when spec is generated.
+	audiences map[*Operation][]string
+	cache     specCache
+	// hosts are roots serving the spec by AddSpec, which cache it too.
+	hosts     []*Root
+	host      HostSetting
+	discovery DiscoveryMode
+	// undocumented routes of echo, set when spec is generated.
+	undocumented []*echo.Route
+	// implemented routes by operationId, see `Implement`.
+	implemented map[string]*echo.Route
+	// generated operations from routes, set when spec is generated.
+	generated map[*Operation]bool
+	// generatedDefs are names of definitions generated from types,
+	// set when spec is generated.
+	generatedDefs map[string]bool
+	// fragments added by MergeSpec, merged when spec is generated.
+	fragments []map[string]interface{}
+	// hooks added by OnSpec.
+	hooks []func(*Swagger) error
+	once  sync.Once
+	err   error
 }
 
 type group struct {
 	routers
 	echoGroup *echo.Group
 	security  []map[string][]string
+	audiences []string
+	hidden    bool
 	tag       Tag
 }
 
 type api struct {
 	route     *echo.Route
 	defs      *RawDefineDic
+	conf      *config
 	security  []map[string][]string
+	audiences []string
+	hidden    bool
 	operation Operation
+	// wildcard is set if "*" of the route is documented as path parameter.
+	wildcard bool
+	// introspect, middleware and source are set if introspection is
+	// enabled when the route is registered.
+	introspect bool
+	middleware []string
+	source     string
 }
 
 // New creates ApiRoot instance.
@@ -239,45 +402,78 @@ func New(e *echo.Echo, docPath string, i *Info, m ...echo.MiddlewareFunc) ApiRoo
 			Definitions:         make(map[string]*JSONSchema),
 		},
 		routers: routers{
-			defs: &defs,
+			defs:  &defs,
+			conf:  &config{},
+			owned: ownedRoutes(e),
 		},
+		docPath: docPath,
 	}
+	r.docMiddlewares = append([]echo.MiddlewareFunc{r.docAuthMiddleware}, m...)
 
-	e.GET(connectPath(docPath), r.docHandler(docPath), m...)
-	e.GET(connectPath(docPath, SpecName), r.specHandler(docPath), m...)
+	r.docGET(connectPath(docPath), r.docHandler(docPath))
+	r.docGET(connectPath(docPath, SpecName), r.specHandler(docPath))
 	return r
 }
 
 func (r *Root) Add(method, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.Add(method, path, h, m...))
+	return r.appendRoute(r.echo.Add(method, path, h, m...), m)
 }
 
 func (r *Root) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.GET(path, h, m...))
+	return r.appendRoute(r.echo.GET(path, h, m...), m)
 }
 
 func (r *Root) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.POST(path, h, m...))
+	return r.appendRoute(r.echo.POST(path, h, m...), m)
 }
 
 func (r *Root) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.PUT(path, h, m...))
+	return r.appendRoute(r.echo.PUT(path, h, m...), m)
 }
 
 func (r *Root) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.DELETE(path, h, m...))
+	return r.appendRoute(r.echo.DELETE(path, h, m...), m)
 }
 
 func (r *Root) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.OPTIONS(path, h, m...))
+	return r.appendRoute(r.echo.OPTIONS(path, h, m...), m)
 }
 
 func (r *Root) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.HEAD(path, h, m...))
+	return r.appendRoute(r.echo.HEAD(path, h, m...), m)
 }
 
 func (r *Root) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	return r.appendRoute(r.echo.PATCH(path, h, m...))
+	return r.appendRoute(r.echo.PATCH(path, h, m...), m)
+}
+
+func (r *Root) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return r.appendRoute(r.echo.CONNECT(path, h, m...), m)
+}
+
+func (r *Root) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return r.appendRoute(r.echo.TRACE(path, h, m...), m)
+}
+
+func (r *Root) Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return r.appendRoutes(r.echo.Any(path, h, m...), m, true)
+}
+
+func (r *Root) Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return r.appendRoutes(r.echo.Match(methods, path, h, m...), m, false)
+}
+
+func (r *Root) Static(prefix, root string) Api {
+	h := staticHandler(root)
+	return r.appendStatic(prefix, func(path string) *echo.Route {
+		return r.echo.GET(path, h)
+	})
+}
+
+func (r *Root) File(path, file string, m ...echo.MiddlewareFunc) Api {
+	a := r.appendRoute(r.echo.GET(path, fileHandler(file), m...), m)
+	a.setFileDownload()
+	return a
 }
 
 func (r *Root) Group(name, prefix string, m ...echo.MiddlewareFunc) ApiGroup {
@@ -288,7 +484,10 @@ func (r *Root) Group(name, prefix string, m ...echo.MiddlewareFunc) ApiGroup {
 	group := group{
 		echoGroup: echoGroup,
 		routers: routers{
-			defs: r.defs,
+			defs:       r.defs,
+			conf:       r.conf,
+			owned:      r.owned,
+			middleware: funcNames(m),
 		},
 	}
 	group.tag = Tag{Name: name}
@@ -303,7 +502,9 @@ func (r *Root) BindGroup(name string, g *echo.Group) ApiGroup {
 	group := group{
 		echoGroup: g,
 		routers: routers{
-			defs: r.defs,
+			defs:  r.defs,
+			conf:  r.conf,
+			owned: r.owned,
 		},
 	}
 	group.tag = Tag{Name: name}
@@ -367,12 +568,38 @@ func (r *Root) AddSecurityOAuth2(name, desc string, flow OAuth2FlowType, authori
 		TokenURL:         tokenUrl,
 		Scopes:           scopes,
 	}
+	r.docGETOnce(connectPath(r.docPath, OAuth2RedirectName), oauth2RedirectHandler)
 	r.spec.SecurityDefinitions[name] = sd
 	return r
 }
 
 func (r *Root) SetUI(ui UISetting) ApiRoot {
+	if ui.Renderer != "" && !isValidRenderer(ui.Renderer) {
+		panic("echoswagger: invalid UI renderer")
+	}
+	for sub, renderer := range ui.SubPaths {
+		if sub = strings.Trim(sub, "/"); sub == "" || sub == SpecName || sub == OAuth2RedirectName || sub == CoverageName || !isValidRenderer(renderer) {
+			panic("echoswagger: invalid UI sub-path")
+		}
+	}
+	if _, err := ui.SwaggerUI.options(); err != nil {
+		panic(err)
+	}
+	nets, err := ui.Auth.parseCIDRs()
+	if err != nil {
+		panic("echoswagger: invalid CIDR of doc auth: " + err.Error())
+	}
+	// Handlers of doc endpoints read the current setting,
+	// routes are registered once and kept.
+	for sub := range ui.SubPaths {
+		sub = strings.Trim(sub, "/")
+		r.docGETOnce(connectPath(r.docPath, sub), r.subPathHandler(sub))
+	}
+	if ui.Coverage {
+		r.docGETOnce(connectPath(r.docPath, CoverageName), r.coverageHandler)
+	}
 	r.ui = ui
+	r.docNets = nets
 	return r
 }
 
@@ -386,67 +613,212 @@ func (r *Root) SetScheme(schemes ...string) ApiRoot {
 	return r
 }
 
+func (r *Root) SetHost(h HostSetting) ApiRoot {
+	if h.Strategy < HostFromReferer || h.Strategy > HostCustom || h.Strategy == HostCustom && h.Resolve == nil {
+		panic("echoswagger: invalid host setting")
+	}
+	for _, s := range h.Schemes {
+		if !isValidScheme(s) {
+			panic("echoswagger: invalid protocol scheme")
+		}
+	}
+	r.host = h
+	r.cache.reset()
+	return r
+}
+
+func (r *Root) SetDiscovery(mode DiscoveryMode) ApiRoot {
+	if mode < DiscoveryIgnore || mode > DiscoveryInclude {
+		panic("echoswagger: invalid discovery mode")
+	}
+	r.discovery = mode
+	return r
+}
+
+func (r *Root) Coverage() (*CoverageReport, error) {
+	spec, err := r.GetSpec(nil, r.docPath)
+	if err != nil {
+		return nil, err
+	}
+	// Operations are copied by hooks, so they're matched by names.
+	raw, _ := r.rawSpec(nil)
+	generated := make(map[string]bool)
+	for path, v := range raw.Paths {
+		if p, ok := v.(*Path); ok {
+			for _, method := range swaggerMethods {
+				if o := p.operation(method); o != nil && r.generated[o] {
+					generated[method+" "+path] = true
+				}
+			}
+		}
+	}
+	return r.coverage(spec, generated), nil
+}
+
+func (r *Root) SetIntrospection(enable bool) ApiRoot {
+	r.conf.introspection = enable
+	return r
+}
+
+func (r *Root) SetValidateTag(name string) ApiRoot {
+	r.conf.validateTag = name
+	return r
+}
+
+func (r *Root) AddAudienceSpec(audience string) ApiRoot {
+	r.addSpecView(audience, r, func(c echo.Context) (Swagger, error) {
+		spec, err := r.rawSpec(c)
+		if err != nil {
+			return spec, err
+		}
+		return r.filterSpec(spec, audience), nil
+	})
+	return r
+}
+
+func (r *Root) AddSpec(name string, root ApiRoot) ApiRoot {
+	o, ok := root.(*Root)
+	if !ok {
+		return r
+	}
+	r.addSpecView(name, o, o.rawSpec)
+	o.hosts = append(o.hosts, r)
+	return r
+}
+
 func (r *Root) GetRaw() *Swagger {
 	return r.spec
 }
 
 func (r *Root) SetRaw(s *Swagger) ApiRoot {
 	r.spec = s
+	r.resetCache()
 	return r
 }
 
+func (r *Root) MergeSpec(fragment []byte) ApiRoot {
+	f, err := parseFragment(fragment)
+	if err != nil {
+		panic("echoswagger: invalid spec fragment: " + err.Error())
+	}
+	// Fragments are merged once when spec is generated, then echo is released.
+	if r.echo == nil {
+		panic("echoswagger: spec fragment can't be merged after spec is generated")
+	}
+	r.fragments = append(r.fragments, f)
+	r.resetCache()
+	return r
+}
+
+func (r *Root) OnSpec(hook func(*Swagger) error) ApiRoot {
+	r.hooks = append(r.hooks, hook)
+	r.resetCache()
+	return r
+}
+
+func (r *Root) Implement(operationId string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) ApiRoot {
+	if r.implemented[operationId] != nil {
+		panic("echoswagger: operation " + operationId + " is implemented")
+	}
+	if r.implemented == nil {
+		r.implemented = make(map[string]*echo.Route)
+	}
+	route := implement(r.echo, r.spec, operationId, h, m...)
+	r.owned.add(route.Method, route.Path)
+	r.implemented[operationId] = route
+	return r
+}
+
+func (r *Root) Unimplemented() []string {
+	return unimplemented(r.spec, r.implemented, r.generated)
+}
+
 func (r *Root) Echo() *echo.Echo {
 	return r.echo
 }
 
 func (g *group) Add(method, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.Add(method, path, h, m...))
+	a := g.appendRoute(g.echoGroup.Add(method, path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
 func (g *group) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.GET(path, h, m...))
+	a := g.appendRoute(g.echoGroup.GET(path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
 func (g *group) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.POST(path, h, m...))
+	a := g.appendRoute(g.echoGroup.POST(path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
 func (g *group) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.PUT(path, h, m...))
+	a := g.appendRoute(g.echoGroup.PUT(path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
 func (g *group) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.DELETE(path, h, m...))
+	a := g.appendRoute(g.echoGroup.DELETE(path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
 func (g *group) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.OPTIONS(path, h, m...))
+	a := g.appendRoute(g.echoGroup.OPTIONS(path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
 func (g *group) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.HEAD(path, h, m...))
+	a := g.appendRoute(g.echoGroup.HEAD(path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
 func (g *group) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
-	a := g.appendRoute(g.echoGroup.PATCH(path, h, m...))
+	a := g.appendRoute(g.echoGroup.PATCH(path, h, m...), m)
+	a.operation.Tags = []string{g.tag.Name}
+	return a
+}
+
+func (g *group) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	a := g.appendRoute(g.echoGroup.CONNECT(path, h, m...), m)
+	a.operation.Tags = []string{g.tag.Name}
+	return a
+}
+
+func (g *group) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	a := g.appendRoute(g.echoGroup.TRACE(path, h, m...), m)
 	a.operation.Tags = []string{g.tag.Name}
 	return a
 }
 
+func (g *group) Any(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return g.setTags(g.appendRoutes(g.echoGroup.Any(path, h, m...), m, true))
+}
+
+func (g *group) Match(methods []string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) Api {
+	return g.setTags(g.appendRoutes(g.echoGroup.Match(methods, path, h, m...), m, false))
+}
+
+func (g *group) Static(prefix, root string) Api {
+	h := staticHandler(root)
+	return g.setTags(g.appendStatic(prefix, func(path string) *echo.Route {
+		return g.echoGroup.GET(path, h)
+	}))
+}
+
+func (g *group) File(path, file string, m ...echo.MiddlewareFunc) Api {
+	a := g.appendRoute(g.echoGroup.GET(path, fileHandler(file), m...), m)
+	a.operation.Tags = []string{g.tag.Name}
+	a.setFileDownload()
+	return a
+}
+
 func (g *group) SetDescription(desc string) ApiGroup {
 	g.tag.Description = desc
 	return g
@@ -460,6 +832,16 @@ func (g *group) SetExternalDocs(desc, url string) ApiGroup {
 	return g
 }
 
+func (g *group) Hidden() ApiGroup {
+	g.hidden = true
+	return g
+}
+
+func (g *group) SetAudiences(audiences ...string) ApiGroup {
+	g.audiences = audiences
+	return g
+}
+
 func (g *group) SetSecurity(names ...string) ApiGroup {
 	if len(names) == 0 {
 		return g
@@ -525,25 +907,58 @@ func (a *api) AddParamFile(name, desc string, required bool) Api {
 	return a
 }
 
+func (a *api) AddParamFiles(name, desc string, required bool) Api {
+	name = a.operation.rename(name)
+	a.operation.Parameters = append(a.operation.Parameters, &Parameter{
+		Name:             name,
+		In:               string(ParamInFormData),
+		Description:      desc,
+		Required:         required,
+		Type:             "array",
+		Items:            &Items{Type: "file"},
+		CollectionFormat: "multi",
+	})
+	return a
+}
+
+func (a *api) AddParamFormJSON(p interface{}, name, desc string, required bool) Api {
+	return a.addFormJSONParams(p, name, desc, required)
+}
+
+func (a *api) SetParamContentType(name string, types ...string) Api {
+	for _, p := range a.operation.Parameters {
+		if p.In == string(ParamInFormData) && p.Name == name {
+			p.setContentType(types)
+			return a
+		}
+	}
+	panic("echoswagger: formData parameter " + name + " not found")
+}
+
 func (a *api) AddResponse(code int, desc string, schema interface{}, header interface{}) Api {
 	r := &Response{
 		Description: desc,
 	}
 
+	var err error
 	st := reflect.TypeOf(schema)
 	if st != nil {
 		if !isValidSchema(st, false) {
 			panic("echoswagger: invalid response schema")
 		}
-		r.Schema = a.defs.genSchema(reflect.ValueOf(schema))
+		if r.Schema, err = a.defs.genSchema(reflect.ValueOf(schema), a.conf); err != nil {
+			panic(err)
+		}
 	}
 
 	ht := reflect.TypeOf(header)
 	if ht != nil {
-		if !isValidParam(reflect.TypeOf(header), true, false) {
+		if !isValidParam(reflect.TypeOf(header), ParamInHeader, true, false) {
 			panic("echoswagger: invalid response header")
 		}
-		r.Headers = a.genHeader(reflect.ValueOf(header))
+		if r.Headers, err = a.genHeader(reflect.ValueOf(header)); err != nil {
+			panic(err)
+		}
 	}
 
 	cstr := strconv.Itoa(code)
@@ -584,6 +999,16 @@ func (a *api) SetExternalDocs(desc, url string) Api {
 	return a
 }
 
+func (a *api) Hidden() Api {
+	a.hidden = true
+	return a
+}
+
+func (a *api) SetAudiences(audiences ...string) Api {
+	a.audiences = audiences
+	return a
+}
+
 func (a *api) SetSummary(summary string) Api {
 	a.operation.Summary = summary
 	return a
@@ -1,6 +1,9 @@
 package echoswagger
 
 import (
+	"encoding/json"
+	"html/template"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -219,6 +222,27 @@ func TestAddParam(t *testing.T) {
 		assert.Equal(t, a.(*api).operation.Parameters[0].Type, "file")
 	})
 
+	t.Run("Files", func(t *testing.T) {
+		a := prepareApi()
+		a.AddParamFiles(name, desc, true)
+		assert.Len(t, a.(*api).operation.Parameters, 1)
+		assert.Equal(t, a.(*api).operation.Parameters[0].Name, name)
+		assert.Equal(t, a.(*api).operation.Parameters[0].In, string(ParamInFormData))
+		assert.Equal(t, a.(*api).operation.Parameters[0].Type, "array")
+		assert.Equal(t, a.(*api).operation.Parameters[0].Items, &Items{Type: "file"})
+		assert.Equal(t, a.(*api).operation.Parameters[0].CollectionFormat, "multi")
+
+		a.AddParamForm(&multipart.FileHeader{}, name, desc, false)
+		assert.Len(t, a.(*api).operation.Parameters, 2)
+		assert.Equal(t, a.(*api).operation.Parameters[1].Name, "name_")
+		assert.Equal(t, a.(*api).operation.Parameters[1].Type, "file")
+		assert.Equal(t, a.(*api).operation.Parameters[1].Format, "")
+
+		assert.Panics(t, func() {
+			a.AddParamQuery(&multipart.FileHeader{}, name, desc, false)
+		})
+	})
+
 	t.Run("Path", func(t *testing.T) {
 		a := prepareApi()
 		a.AddParamPath(time.Now(), name, desc)
@@ -397,6 +421,138 @@ func TestUI(t *testing.T) {
 			assert.Contains(t, rec.Body.String(), "#swagger-ui>.swagger-container>.topbar")
 		}
 	})
+
+	t.Run("Renderers", func(t *testing.T) {
+		e := echo.New()
+		r := New(e, "doc/", nil)
+		r.SetUI(UISetting{
+			Renderer: UIReDoc,
+			SubPaths: map[string]UIRenderer{
+				"swagger":  UISwaggerUI,
+				"/rapidoc": UIRapiDoc,
+				"scalar":   UIScalar,
+			},
+		})
+
+		cases := map[string]string{
+			"/doc/":        DefaultReDocCDN,
+			"/doc/swagger": DefaultCDN,
+			"/doc/rapidoc": DefaultRapiDocCDN,
+			"/doc/scalar":  DefaultScalarCDN,
+		}
+		for path, cdn := range cases {
+			req := httptest.NewRequest(echo.GET, path, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code, path)
+			assert.Contains(t, rec.Body.String(), cdn, path)
+		}
+
+		req := httptest.NewRequest(echo.GET, "/doc/swagger.json", nil)
+		req.Header.Set("Referer", "http://localhost:1323/api/doc/rapidoc")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"basePath":"/api"`)
+	})
+
+	t.Run("SetAgain", func(t *testing.T) {
+		e := echo.New()
+		r := New(e, "doc/", nil)
+		r.SetUI(UISetting{SubPaths: map[string]UIRenderer{"redoc": UIReDoc, "alt": UIRapiDoc}})
+		r.SetUI(UISetting{Renderer: UIScalar, SubPaths: map[string]UIRenderer{"/redoc": UISwaggerUI}})
+		assert.Len(t, e.Routes(), 4)
+
+		cases := map[string]string{
+			"/doc/":      DefaultScalarCDN,
+			"/doc/redoc": DefaultCDN,
+		}
+		for path, cdn := range cases {
+			req := httptest.NewRequest(echo.GET, path, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusOK, rec.Code, path)
+			assert.Contains(t, rec.Body.String(), cdn, path)
+		}
+		req := httptest.NewRequest(echo.GET, "/doc/alt", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		r.SetUI(UISetting{SubPaths: map[string]UIRenderer{"redoc": UIReDoc}})
+		assert.PanicsWithValue(t, "echoswagger: doc endpoint /doc/scalar can't be added after spec is generated", func() {
+			r.SetUI(UISetting{SubPaths: map[string]UIRenderer{"scalar": UIScalar}})
+		})
+	})
+
+	t.Run("Template", func(t *testing.T) {
+		e := echo.New()
+		r := New(e, "doc/", nil)
+		r.SetUI(UISetting{
+			Template: template.Must(template.New("").Parse(`<h1>{{.title}}</h1><a href="{{.specPath}}">spec</a>`)),
+		})
+		req := httptest.NewRequest(echo.GET, "/doc/", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `<h1>Project APIs</h1><a href="/doc/swagger.json">spec</a>`, rec.Body.String())
+	})
+
+	t.Run("SwaggerUIConfig", func(t *testing.T) {
+		e := echo.New()
+		r := New(e, "doc/", nil)
+		depth := -1
+		r.SetUI(UISetting{
+			DetachSpec: true,
+			SwaggerUI: SwaggerUIConfig{
+				DocExpansion:             "none",
+				DefaultModelsExpandDepth: &depth,
+				FilterText:               "</script>",
+				PersistAuthorization:     true,
+				SupportedSubmitMethods:   []string{},
+				InitOAuth:                &SwaggerUIOAuth{ClientId: "client", UsePkceWithAuthorizationCodeGrant: true},
+				Raw:                      json.RawMessage(`{"layout":"BaseLayout","deepLinking":false}`),
+			},
+		})
+		req := httptest.NewRequest(echo.GET, "/doc/", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		body := rec.Body.String()
+		assert.Contains(t, body, `"docExpansion":"none"`)
+		assert.Contains(t, body, `"defaultModelsExpandDepth":-1`)
+		assert.Contains(t, body, `"filter":"\u003c/script\u003e"`)
+		assert.Contains(t, body, `"persistAuthorization":true`)
+		assert.Contains(t, body, `"supportedSubmitMethods":[]`)
+		assert.Contains(t, body, `"layout":"BaseLayout"`)
+		assert.Contains(t, body, `"deepLinking":false`)
+		assert.Contains(t, body, `ui.initOAuth({"clientId":"client","usePkceWithAuthorizationCodeGrant":true})`)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		r := New(echo.New(), "doc/", nil)
+		assert.Panics(t, func() {
+			r.SetUI(UISetting{Renderer: "unknown"})
+		})
+		assert.Panics(t, func() {
+			r.SetUI(UISetting{SubPaths: map[string]UIRenderer{"/": UIReDoc}})
+		})
+		assert.Panics(t, func() {
+			r.SetUI(UISetting{SubPaths: map[string]UIRenderer{"swagger.json": UIReDoc}})
+		})
+		assert.Panics(t, func() {
+			r.SetUI(UISetting{SubPaths: map[string]UIRenderer{"redoc": "unknown"}})
+		})
+		assert.Panics(t, func() {
+			r.SetUI(UISetting{SwaggerUI: SwaggerUIConfig{DocExpansion: "all"}})
+		})
+		assert.Panics(t, func() {
+			r.SetUI(UISetting{SwaggerUI: SwaggerUIConfig{SupportedSubmitMethods: []string{"connect"}}})
+		})
+		assert.Panics(t, func() {
+			r.SetUI(UISetting{SwaggerUI: SwaggerUIConfig{Raw: json.RawMessage(`[]`)}})
+		})
+	})
 }
 
 func TestScheme(t *testing.T) {
@@ -547,3 +703,85 @@ func TestHandlers(t *testing.T) {
 		}
 	})
 }
+
+func TestUpload(t *testing.T) {
+	type Photo struct {
+		Caption string `json:"caption"`
+	}
+	type Upload struct {
+		Name   string                  `form:"name" swagger:"required"`
+		Avatar *multipart.FileHeader   `form:"avatar" swagger:"desc(Avatar of pet),contentType(image/png|image/jpeg)"`
+		Photos []*multipart.FileHeader `form:"photos"`
+	}
+	r := New(echo.New(), "doc/", nil)
+	r.POST("/pets", testHandler).AddParamFormNested(&Upload{})
+	r.POST("/pets/:id/photos", testHandler).
+		AddParamFiles("photos", "Photos of pet", true).
+		SetParamContentType("photos", "image/png").
+		AddParamFormJSON(&Photo{}, "metadata", "Metadata of photos", false)
+	r.POST("/pets/:id/avatar", testHandler).
+		AddParamFile("avatar", "Avatar of pet", true).
+		SetRequestContentType("application/x-www-form-urlencoded")
+
+	assert.PanicsWithValue(t, "echoswagger: formData parameter photo not found", func() {
+		r.POST("/owners", testHandler).AddParamQuery("", "photo", "", false).SetParamContentType("photo", "image/png")
+	})
+	func() {
+		defer func() {
+			err, ok := recover().(error)
+			if assert.True(t, ok) {
+				assert.EqualError(t, err, "echoswagger: invalid swagger tag contentType(image/png) of field Avatar: only formData parameters have content types")
+			}
+		}()
+		r.GET("/owners", testHandler).AddParamQueryNested(&struct {
+			Avatar string `query:"avatar" swagger:"contentType(image/png)"`
+		}{})
+	}()
+
+	spec, err := r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+
+	o := spec.Paths["/pets"].(*Path).Post
+	assert.Equal(t, []string{echo.MIMEMultipartForm}, o.Consumes)
+	if assert.Len(t, o.Parameters, 3) {
+		assert.Equal(t, &Parameter{
+			Name:        "avatar",
+			In:          string(ParamInFormData),
+			Description: "Avatar of pet",
+			Type:        "file",
+			Extensions:  map[string]interface{}{"x-content-type": "image/png, image/jpeg"},
+		}, o.Parameters[1])
+		assert.Equal(t, &Parameter{
+			Name:             "photos",
+			In:               string(ParamInFormData),
+			Type:             "array",
+			Items:            &Items{Type: "file"},
+			CollectionFormat: "multi",
+		}, o.Parameters[2])
+	}
+
+	o = spec.Paths["/pets/{id}/photos"].(*Path).Post
+	assert.Equal(t, []string{echo.MIMEMultipartForm}, o.Consumes)
+	if assert.Len(t, o.Parameters, 2) {
+		assert.Equal(t, "image/png", o.Parameters[0].Extensions["x-content-type"])
+		metadata := o.Parameters[1]
+		assert.Equal(t, "string", metadata.Type)
+		assert.Equal(t, "application/json", metadata.Extensions["x-content-type"])
+		assert.Equal(t, &JSONSchema{Ref: DefPrefix + "Photo"}, metadata.Extensions["x-schema"])
+	}
+	assert.Contains(t, spec.Definitions, "Photo")
+
+	o = spec.Paths["/pets/{id}/avatar"].(*Path).Post
+	assert.Equal(t, []string{"application/x-www-form-urlencoded"}, o.Consumes)
+
+	// Type "file" is only allowed in formData.
+	r = New(echo.New(), "doc/", nil)
+	r.POST("/pets", testHandler).AddParamBody(&Upload{}, "body", "", true)
+	spec, err = r.(*Root).GetSpec(nil, "/doc")
+	assert.NoError(t, err)
+	props := spec.Definitions["Upload"].Properties
+	assert.EqualValues(t, "string", props["Avatar"].Type)
+	assert.Equal(t, "binary", props["Avatar"].Format)
+	assert.EqualValues(t, "string", props["Photos"].Items.Type)
+	assert.Equal(t, "binary", props["Photos"].Items.Format)
+}